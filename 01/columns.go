@@ -0,0 +1,139 @@
+package day01
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+)
+
+// Columns are any number of location lists side by side, such as the lists of several historians.
+type Columns struct {
+	Names []string
+	Lists [][]int
+}
+
+// ParseColumns reads location lists with at least two columns, all lines having the same number of them.
+// With header, the first line names the columns, otherwise they are named 1, 2, ...
+func ParseColumns(r io.Reader, header bool) (Columns, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return Columns{}, err
+	}
+
+	var c Columns
+	first := 1
+	if header {
+		if len(lines) == 0 {
+			return Columns{}, errors.New("missing the header naming the columns")
+		}
+		c.Names = input.Fields(lines[0], "")
+		lines, first = lines[1:], 2
+	}
+
+	rows, err := input.Parse(lines, first, func(line string) ([]int, error) {
+		fields := input.Fields(line, "")
+		row := make([]int, len(fields))
+		for i, field := range fields {
+			n, err := strconv.Atoi(field)
+			if err != nil {
+				return nil, fmt.Errorf("error converting '%s' to integer: %w", field, err)
+			}
+			row[i] = n
+		}
+		return row, nil
+	})
+	if err != nil {
+		return Columns{}, err
+	}
+
+	k := len(c.Names)
+	if !header && len(rows) > 0 {
+		k = len(rows[0])
+		for i := range k {
+			c.Names = append(c.Names, strconv.Itoa(i+1))
+		}
+	}
+	if k < 2 {
+		return Columns{}, fmt.Errorf("expected at least 2 columns, but got %d", k)
+	}
+
+	c.Lists = make([][]int, k)
+	for i, row := range rows {
+		if len(row) != k {
+			return Columns{}, &input.LineError{Line: first + i, Err: fmt.Errorf("each line must contain exactly %d elements, but got %d", k, len(row))}
+		}
+		for j, n := range row {
+			c.Lists[j] = append(c.Lists[j], n)
+		}
+	}
+	return c, nil
+}
+
+// Metrics comparing two columns
+const (
+	Distance   = "distance"   // sum of the differences of the sorted columns, as in part I
+	Similarity = "similarity" // similarity score, as in part II
+)
+
+// Matrix compares every pair of columns with metric: row i and column j compare list i with list j.
+func (c Columns) Matrix(metric string) ([][]int, error) {
+	var compare func(i, j int) int
+	switch metric {
+	case Distance:
+		sorted := make([][]int, len(c.Lists))
+		for i, list := range c.Lists {
+			sorted[i] = slices.Sorted(slices.Values(list))
+		}
+		compare = func(i, j int) int { return calculateSumOfDiffs(sorted[i], sorted[j]) }
+	case Similarity:
+		frequencies := make([]map[int]int, len(c.Lists))
+		for i, list := range c.Lists {
+			frequencies[i] = createFrequencyMap(list)
+		}
+		compare = func(i, j int) int { return calculateSimilarityScore(c.Lists[i], frequencies[j]) }
+	default:
+		return nil, fmt.Errorf("unknown metric %q, should be %s or %s", metric, Distance, Similarity)
+	}
+
+	m := make([][]int, len(c.Lists))
+	for i := range m {
+		m[i] = make([]int, len(c.Lists))
+		for j := range m[i] {
+			m[i][j] = compare(i, j)
+		}
+	}
+	return m, nil
+}
+
+// WriteMatrix writes a matrix with the names of its rows and columns, as an aligned table for format "text", or "csv".
+func WriteMatrix(w io.Writer, names []string, m [][]int, format string) error {
+	records := [][]string{append([]string{""}, names...)}
+	for i, row := range m {
+		record := []string{names[i]}
+		for _, v := range row {
+			record = append(record, strconv.Itoa(v))
+		}
+		records = append(records, record)
+	}
+
+	switch format {
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+		for _, record := range records {
+			fmt.Fprintln(tw, strings.Join(record, "\t")+"\t")
+		}
+		return tw.Flush()
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.WriteAll(records)
+		return cw.Error()
+	}
+	return fmt.Errorf("unknown format %q, should be text or csv", format)
+}
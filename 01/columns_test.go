@@ -0,0 +1,88 @@
+package day01
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// The example, with a third list
+const threeLists = `alice bob carol
+3   4   3
+4   3   3
+2   5   1
+1   3   9
+3   9   2
+3   3   3
+`
+
+func TestMatrix(t *testing.T) {
+	c, err := ParseColumns(strings.NewReader(threeLists), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(c.Names, []string{"alice", "bob", "carol"}) || len(c.Lists) != 3 || len(c.Lists[2]) != 6 {
+		t.Fatalf("ParseColumns() = %v", c)
+	}
+
+	tests := []struct {
+		metric string
+		want   [][]int
+	}{
+		// Alice and Bob's lists are the example, 11 and 31 apart
+		{Distance, [][]int{{0, 11, 5}, {11, 0, 6}, {5, 6, 0}}},
+		{Similarity, [][]int{{34, 31, 30}, {31, 45, 36}, {30, 36, 39}}},
+	}
+	for _, tt := range tests {
+		m, err := c.Matrix(tt.metric)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !slices.EqualFunc(m, tt.want, slices.Equal) {
+			t.Errorf("%s matrix = %v, want %v", tt.metric, m, tt.want)
+		}
+	}
+	if _, err := c.Matrix("manhattan"); err == nil {
+		t.Error("expected an error for an unknown metric")
+	}
+}
+
+func TestParseColumns(t *testing.T) {
+	c, err := ParseColumns(strings.NewReader(example), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(c.Names, []string{"1", "2"}) || !slices.Equal(c.Lists[1], []int{4, 3, 5, 3, 9, 3}) {
+		t.Errorf("ParseColumns() = %v", c)
+	}
+
+	for _, text := range []string{"1 2\n3\n", "1\n2\n", "a b\n1 2 3\n", "1 x\n", ""} {
+		if _, err := ParseColumns(strings.NewReader(text), strings.HasPrefix(text, "a")); err == nil {
+			t.Errorf("expected an error for %q", text)
+		}
+	}
+}
+
+func TestWriteMatrix(t *testing.T) {
+	names := []string{"a", "bb"}
+	m := [][]int{{0, 12}, {12, 0}}
+
+	var out strings.Builder
+	if err := WriteMatrix(&out, names, m, "csv"); err != nil {
+		t.Fatal(err)
+	}
+	if want := ",a,bb\na,0,12\nbb,12,0\n"; out.String() != want {
+		t.Errorf("csv = %q, want %q", out.String(), want)
+	}
+
+	out.Reset()
+	if err := WriteMatrix(&out, names, m, "text"); err != nil {
+		t.Fatal(err)
+	}
+	if want := "       a  bb\n   a   0  12\n  bb  12   0\n"; out.String() != want {
+		t.Errorf("text =\n%s\nwant\n%s", out.String(), want)
+	}
+	if err := WriteMatrix(&out, names, m, "xml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
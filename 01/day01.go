@@ -0,0 +1,140 @@
+package day01
+
+import (
+	"context"
+	"fmt" // For printing
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+func AbsInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Parses a line into its left and right numbers
+func parseLine(line string) (left, right int, err error) {
+	numStrs := input.Fields(line, "") // Splits by whitespaces
+	if len(numStrs) != 2 {
+		return 0, 0, fmt.Errorf("each line must contain exactly 2 elements, but got %d", len(numStrs))
+	}
+
+	// Convert string to integer
+	left, err = strconv.Atoi(numStrs[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("error converting '%s' to integer: %w", numStrs[0], err)
+	}
+	right, err = strconv.Atoi(numStrs[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("error converting '%s' to integer: %w", numStrs[1], err)
+	}
+	return left, right, nil
+}
+
+// Parses each line, returning two lists of ints, for left and rigth column of text.
+func parseInput(data []string) (leftArray, rightArray []int, err error) {
+	for i, line := range data {
+		left, right, err := parseLine(line)
+		if err != nil {
+			return nil, nil, &input.LineError{Line: i + 1, Err: err}
+		}
+
+		// Add to respective arrays
+		leftArray = append(leftArray, left)
+		rightArray = append(rightArray, right)
+	}
+
+	return leftArray, rightArray, nil
+}
+
+// calculateSumOfDiffs calculates the sum of absolute differences between two arrays
+func calculateSumOfDiffs(leftArray, rightArray []int) int {
+	// Iterate over the arrays
+	minLength := len(leftArray)
+	if len(rightArray) < minLength {
+		minLength = len(rightArray)
+	}
+
+	sum := 0
+	for i := 0; i < minLength; i++ {
+		diff := leftArray[i] - rightArray[i]
+		sum += AbsInt(diff)
+	}
+	return sum
+}
+
+// Given an array of ints, create a map that shows frequency for each number
+func createFrequencyMap(arr []int) map[int]int {
+	res := make(map[int]int)
+
+	for _, num := range arr {
+		res[num]++
+	}
+
+	return res
+}
+
+func calculateSimilarityScore(arr []int, frequencyMap map[int]int) int {
+	score := 0
+	for _, num := range arr {
+		score += num * frequencyMap[num]
+	}
+	return score
+}
+
+// Left and right location lists
+type LocationLists struct {
+	Left, Right []int
+}
+
+// Parse reads the left and right location lists.
+func Parse(r io.Reader) (LocationLists, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return LocationLists{}, err
+	}
+
+	leftArray, rightArray, err := parseInput(lines)
+	if err != nil {
+		return LocationLists{}, err
+	}
+	return LocationLists{leftArray, rightArray}, nil
+}
+
+/*
+PART I
+Sorting the array, should be enough to solve this.
+After sorting go over two arrays, and add their difference (don't forget the absolute value!)
+Due to sorting, solution is O(n*log(n))
+*/
+func Part1(ctx context.Context, lists LocationLists) (string, error) {
+	sort.Ints(lists.Left)
+	sort.Ints(lists.Right)
+
+	sum := calculateSumOfDiffs(lists.Left, lists.Right)
+	return strconv.Itoa(sum), nil
+}
+
+/*
+PART II
+Create a histogram for each element in the right list (as hashmap)
+building the map takes O(m), since we need to get over the entire list
+later for each term in left list, we need to search hashmap, which is O(1) for each, total O(n)
+So solution is : O(n+m), where n, m is length of left, right list
+n = m, so solution is O(n)
+*/
+func Part2(ctx context.Context, lists LocationLists) (string, error) {
+	rightMap := createFrequencyMap(lists.Right)
+	score := calculateSimilarityScore(lists.Left, rightMap)
+	return strconv.Itoa(score), nil
+}
+
+func init() {
+	solver.Register(1, solver.New(Parse, Part1, Part2))
+}
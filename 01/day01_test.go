@@ -0,0 +1,68 @@
+package day01
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+)
+
+const example = `3   4
+4   3
+2   5
+1   3
+3   9
+3   3
+`
+
+func TestParseInput(t *testing.T) {
+	lines, err := input.Lines(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	left, right, err := parseInput(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{3, 4, 2, 1, 3, 3}; !slices.Equal(left, want) {
+		t.Errorf("left = %v, want %v", left, want)
+	}
+	if want := []int{4, 3, 5, 3, 9, 3}; !slices.Equal(right, want) {
+		t.Errorf("right = %v, want %v", right, want)
+	}
+
+	if _, _, err := parseInput([]string{"1 2 3"}); err == nil {
+		t.Error("expected an error for a line with 3 elements")
+	}
+}
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, LocationLists) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "11"},
+		{"part 2", Part2, "31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lists, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), lists)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package day01
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"slices"
+	"strconv"
+)
+
+// Pair is a row of the sorted lists, paired like in part I.
+type Pair struct {
+	Rank      int  `json:"rank"` // row in the sorted lists, from 1
+	Left      int  `json:"left"`
+	Right     int  `json:"right"`
+	Diff      int  `json:"diff"`
+	OnlyLeft  bool `json:"only_left"`  // Left isn't in the right list
+	OnlyRight bool `json:"only_right"` // Right isn't in the left list
+}
+
+// Contributor is a location ID in both lists, and what it adds to the similarity score.
+type Contributor struct {
+	Value      int `json:"value"`
+	LeftCount  int `json:"left_count"`
+	RightCount int `json:"right_count"`
+	Score      int `json:"score"`
+}
+
+// Report explains the distance and similarity of two lists.
+type Report struct {
+	Distance      int           `json:"distance"`
+	Similarity    int           `json:"similarity"`
+	Pairs         []Pair        `json:"pairs"`
+	TopDistance   []Pair        `json:"top_distance"`   // the pairs adding the most to the distance
+	TopSimilarity []Contributor `json:"top_similarity"` // the location IDs adding the most to the similarity score
+}
+
+// Reconcile pairs the sorted lists, and finds the top pairs and location IDs driving the distance and the similarity score.
+// The lists are left as they are, and a negative top keeps no top entries.
+func Reconcile(lists LocationLists, top int) Report {
+	top = max(top, 0)
+	left := slices.Sorted(slices.Values(lists.Left))
+	right := slices.Sorted(slices.Values(lists.Right))
+	leftMap, rightMap := createFrequencyMap(left), createFrequencyMap(right)
+
+	r := Report{
+		Distance:   calculateSumOfDiffs(left, right),
+		Similarity: calculateSimilarityScore(left, rightMap),
+	}
+	for i := range min(len(left), len(right)) {
+		r.Pairs = append(r.Pairs, Pair{
+			Rank:      i + 1,
+			Left:      left[i],
+			Right:     right[i],
+			Diff:      AbsInt(left[i] - right[i]),
+			OnlyLeft:  rightMap[left[i]] == 0,
+			OnlyRight: leftMap[right[i]] == 0,
+		})
+	}
+
+	r.TopDistance = slices.SortedStableFunc(slices.Values(r.Pairs), func(a, b Pair) int { return cmp.Compare(b.Diff, a.Diff) })
+	r.TopDistance = r.TopDistance[:min(top, len(r.TopDistance))]
+
+	for value, count := range leftMap {
+		if rightMap[value] > 0 {
+			r.TopSimilarity = append(r.TopSimilarity, Contributor{value, count, rightMap[value], value * count * rightMap[value]})
+		}
+	}
+	slices.SortFunc(r.TopSimilarity, func(a, b Contributor) int {
+		return cmp.Or(cmp.Compare(b.Score, a.Score), cmp.Compare(a.Value, b.Value))
+	})
+	r.TopSimilarity = r.TopSimilarity[:min(top, len(r.TopSimilarity))]
+	return r
+}
+
+// WriteJSON writes the report as a JSON object.
+func (r Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// WriteCSV writes the report as blocks of CSV, each with its header and separated by an empty line:
+// the totals, the pairs, the top pairs by distance, and the top location IDs by similarity.
+func (r Report) WriteCSV(w io.Writer) error {
+	itoa := strconv.Itoa
+	pairs := func(pairs []Pair) [][]string {
+		records := [][]string{{"rank", "left", "right", "diff", "only_in"}}
+		for _, p := range pairs {
+			onlyIn := ""
+			switch {
+			case p.OnlyLeft && p.OnlyRight:
+				onlyIn = "both"
+			case p.OnlyLeft:
+				onlyIn = "left"
+			case p.OnlyRight:
+				onlyIn = "right"
+			}
+			records = append(records, []string{itoa(p.Rank), itoa(p.Left), itoa(p.Right), itoa(p.Diff), onlyIn})
+		}
+		return records
+	}
+
+	totals := [][]string{{"distance", "similarity"}, {itoa(r.Distance), itoa(r.Similarity)}}
+	contributors := [][]string{{"value", "left_count", "right_count", "score"}}
+	for _, c := range r.TopSimilarity {
+		contributors = append(contributors, []string{itoa(c.Value), itoa(c.LeftCount), itoa(c.RightCount), itoa(c.Score)})
+	}
+
+	cw := csv.NewWriter(w)
+	for i, block := range [][][]string{totals, pairs(r.Pairs), pairs(r.TopDistance), contributors} {
+		if i > 0 {
+			cw.Write(nil)
+		}
+		cw.WriteAll(block)
+	}
+	return cw.Error()
+}
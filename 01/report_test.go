@@ -0,0 +1,78 @@
+package day01
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestReconcile(t *testing.T) {
+	lists, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	unsorted := slices.Clone(lists.Left)
+
+	r := Reconcile(lists, 2)
+	if r.Distance != 11 || r.Similarity != 31 {
+		t.Errorf("totals = %d, %d, want 11, 31", r.Distance, r.Similarity)
+	}
+	if !slices.Equal(lists.Left, unsorted) {
+		t.Error("Reconcile sorted the lists")
+	}
+
+	wantPairs := []Pair{
+		{1, 1, 3, 2, true, false},
+		{2, 2, 3, 1, true, false},
+		{3, 3, 3, 0, false, false},
+		{4, 3, 4, 1, false, false},
+		{5, 3, 5, 2, false, true},
+		{6, 4, 9, 5, false, true},
+	}
+	if !slices.Equal(r.Pairs, wantPairs) {
+		t.Errorf("pairs = %v, want %v", r.Pairs, wantPairs)
+	}
+
+	// Ties keep the order of the sorted lists
+	if want := []Pair{wantPairs[5], wantPairs[0]}; !slices.Equal(r.TopDistance, want) {
+		t.Errorf("top distance = %v, want %v", r.TopDistance, want)
+	}
+	if want := []Contributor{{3, 3, 3, 27}, {4, 1, 1, 4}}; !slices.Equal(r.TopSimilarity, want) {
+		t.Errorf("top similarity = %v, want %v", r.TopSimilarity, want)
+	}
+
+	if r := Reconcile(lists, -1); len(r.TopDistance) != 0 || len(r.TopSimilarity) != 0 || len(r.Pairs) != 6 {
+		t.Errorf("negative top: report = %+v, want no top entries", r)
+	}
+}
+
+func TestWriteReport(t *testing.T) {
+	lists, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := Reconcile(lists, 1)
+
+	var out bytes.Buffer
+	if err := r.WriteCSV(&out); err != nil {
+		t.Fatal(err)
+	}
+	blocks := strings.Split(out.String(), "\n\n")
+	if len(blocks) != 4 || blocks[0] != "distance,similarity\n11,31" || !strings.Contains(blocks[1], "\n1,1,3,2,left\n") || blocks[3] != "value,left_count,right_count,score\n3,3,3,27\n" {
+		t.Errorf("csv =\n%s", out.String())
+	}
+
+	out.Reset()
+	if err := r.WriteJSON(&out); err != nil {
+		t.Fatal(err)
+	}
+	var decoded Report
+	if err := json.Unmarshal(out.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Distance != 11 || len(decoded.Pairs) != 6 || !decoded.Pairs[5].OnlyRight || len(decoded.TopSimilarity) != 1 {
+		t.Errorf("json = %s", out.String())
+	}
+}
@@ -0,0 +1,278 @@
+package day01
+
+import (
+	"bufio"
+	"cmp"
+	"container/heap"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+)
+
+// DefaultBudget is how many numbers of each column Stream keeps in memory before spilling them to disk, 8MiB a column.
+const DefaultBudget = 1 << 20
+
+// Stream solves both parts in a single pass over the location lists, for lists larger than memory.
+// Every budget numbers of a column are sorted and spilled to a temporary file in dir (the default temporary directory if empty).
+// The sorted runs of both columns are then merged back twice: side by side to pair the numbers for the distance,
+// and by value to count the equal ones for the similarity score.
+func Stream(ctx context.Context, r io.Reader, budget int, dir string) (distance, similarity int, err error) {
+	left, right := newExternalSort(budget, dir), newExternalSort(budget, dir)
+	defer func() {
+		err = errors.Join(err, left.Close(), right.Close())
+	}()
+
+	scanner := bufio.NewScanner(r)
+	blank := 0 // First empty line, only allowed at the end
+	for n := 1; scanner.Scan(); n++ {
+		if n%4096 == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, 0, err
+			}
+		}
+
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			blank = cmp.Or(blank, n)
+			continue
+		}
+		if blank > 0 {
+			return 0, 0, &input.LineError{Line: blank, Err: errors.New("unexpected empty line")}
+		}
+
+		l, r, err := parseLine(line)
+		if err != nil {
+			return 0, 0, &input.LineError{Line: n, Err: err}
+		}
+		if err := errors.Join(left.Add(l), right.Add(r)); err != nil {
+			return 0, 0, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, 0, fmt.Errorf("could not read input: %w", err)
+	}
+
+	lefts, err := left.Sorted()
+	if err != nil {
+		return 0, 0, err
+	}
+	rights, err := right.Sorted()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	for n := 1; ; n++ {
+		if n%4096 == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, 0, err
+			}
+		}
+
+		// Both columns have a number on every line, so they end together
+		l, okL := lefts.Next()
+		r, okR := rights.Next()
+		if !okL || !okR {
+			break
+		}
+		distance += AbsInt(l - r)
+	}
+	if err := errors.Join(lefts.Err(), rights.Err()); err != nil {
+		return 0, 0, err
+	}
+
+	// The runs are read again from the start, merging the columns by value
+	if lefts, err = left.Sorted(); err != nil {
+		return 0, 0, err
+	}
+	if rights, err = right.Sorted(); err != nil {
+		return 0, 0, err
+	}
+	similarity, err = sortedSimilarity(ctx, lefts, rights)
+	if err != nil {
+		return 0, 0, err
+	}
+	return distance, similarity, nil
+}
+
+// externalSort sorts more numbers than fit in memory, spilling sorted runs of budget numbers to temporary files.
+type externalSort struct {
+	budget int
+	dir    string
+	buf    []int
+	runs   []*os.File
+}
+
+func newExternalSort(budget int, dir string) *externalSort {
+	return &externalSort{budget: max(budget, 1), dir: dir}
+}
+
+// Add adds a number, spilling a run once budget numbers are in memory.
+func (s *externalSort) Add(v int) error {
+	s.buf = append(s.buf, v)
+	if len(s.buf) >= s.budget {
+		return s.spill()
+	}
+	return nil
+}
+
+// Writes the numbers in memory to a new run file, sorted and varint encoded
+func (s *externalSort) spill() error {
+	f, err := os.CreateTemp(s.dir, "day01-run-*")
+	if err != nil {
+		return fmt.Errorf("could not spill sorted numbers: %w", err)
+	}
+	s.runs = append(s.runs, f)
+
+	slices.Sort(s.buf)
+	w := bufio.NewWriter(f)
+	var b []byte
+	for _, v := range s.buf {
+		b = binary.AppendVarint(b[:0], int64(v))
+		w.Write(b) // a failed write fails Flush too
+	}
+	if err := w.Flush(); err != nil {
+		return fmt.Errorf("could not spill sorted numbers: %w", err)
+	}
+	s.buf = s.buf[:0]
+	return nil
+}
+
+// Sorted merges the runs and the numbers still in memory into a single sorted sequence.
+func (s *externalSort) Sorted() (*merger, error) {
+	slices.Sort(s.buf)
+	m := &merger{}
+	for _, f := range s.runs {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, fmt.Errorf("could not read sorted numbers: %w", err)
+		}
+		r := bufio.NewReader(f)
+		m.add(&run{next: func() (int, error) {
+			v, err := binary.ReadVarint(r)
+			return int(v), err
+		}})
+	}
+
+	buf := s.buf
+	m.add(&run{next: func() (int, error) {
+		if len(buf) == 0 {
+			return 0, io.EOF
+		}
+		v := buf[0]
+		buf = buf[1:]
+		return v, nil
+	}})
+
+	heap.Init(m)
+	return m, m.err
+}
+
+// Close removes the run files.
+func (s *externalSort) Close() error {
+	var errs []error
+	for _, f := range s.runs {
+		errs = append(errs, f.Close(), os.Remove(f.Name()))
+	}
+	s.runs = nil
+	return errors.Join(errs...)
+}
+
+// A sorted run of numbers, on disk or in memory
+type run struct {
+	head int                 // the smallest number not taken yet
+	next func() (int, error) // io.EOF at the end of the run
+}
+
+// merger takes the numbers of sorted runs in order, keeping the runs in a heap by their head.
+type merger struct {
+	runs []*run
+	err  error
+}
+
+// Adds a run, unless it's empty
+func (m *merger) add(r *run) {
+	v, err := r.next()
+	switch {
+	case err == nil:
+		r.head = v
+		m.runs = append(m.runs, r)
+	case err != io.EOF:
+		m.err = fmt.Errorf("could not read sorted numbers: %w", err)
+	}
+}
+
+// Next returns the smallest number left, and false once all are taken or reading a run failed.
+func (m *merger) Next() (int, bool) {
+	if m.err != nil || len(m.runs) == 0 {
+		return 0, false
+	}
+
+	r := m.runs[0]
+	v := r.head
+	next, err := r.next()
+	switch {
+	case err == nil:
+		r.head = next
+		heap.Fix(m, 0)
+	case err == io.EOF:
+		heap.Pop(m)
+	default:
+		m.err = fmt.Errorf("could not read sorted numbers: %w", err)
+		return 0, false
+	}
+	return v, true
+}
+
+// Err returns the error that stopped Next early, if any.
+func (m *merger) Err() error { return m.err }
+
+func (m *merger) Len() int           { return len(m.runs) }
+func (m *merger) Less(i, j int) bool { return m.runs[i].head < m.runs[j].head }
+func (m *merger) Swap(i, j int)      { m.runs[i], m.runs[j] = m.runs[j], m.runs[i] }
+func (m *merger) Push(x any)         { m.runs = append(m.runs, x.(*run)) }
+func (m *merger) Pop() any {
+	r := m.runs[len(m.runs)-1]
+	m.runs = m.runs[:len(m.runs)-1]
+	return r
+}
+
+// sortedSimilarity computes the similarity score of two sorted columns in a single pass by value, without a frequency map of a whole column.
+// Only how many times each column holds the current number is kept.
+func sortedSimilarity(ctx context.Context, lefts, rights *merger) (int, error) {
+	total := 0
+	l, okL := lefts.Next()
+	r, okR := rights.Next()
+	for n := 1; okL && okR; n++ {
+		if n%4096 == 0 {
+			if err := ctx.Err(); err != nil {
+				return 0, err
+			}
+		}
+
+		switch {
+		case l < r:
+			l, okL = lefts.Next()
+		case r < l:
+			r, okR = rights.Next()
+		default:
+			v, countL, countR := l, 0, 0
+			for ; okL && l == v; countL++ {
+				l, okL = lefts.Next()
+			}
+			for ; okR && r == v; countR++ {
+				r, okR = rights.Next()
+			}
+			total += v * countL * countR
+		}
+	}
+	if err := errors.Join(lefts.Err(), rights.Err()); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
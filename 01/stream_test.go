@@ -0,0 +1,106 @@
+package day01
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Solves both parts in memory, the way Part1 and Part2 do
+func solveInMemory(t *testing.T, text string) (int, int) {
+	t.Helper()
+	lists, err := Parse(strings.NewReader(text))
+	if err != nil {
+		t.Fatal(err)
+	}
+	score := calculateSimilarityScore(lists.Left, createFrequencyMap(lists.Right))
+	distance, _ := Part1(context.Background(), lists)
+	d, _ := strconv.Atoi(distance)
+	return d, score
+}
+
+func TestStream(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	var b strings.Builder
+	for range 5000 {
+		// Few distinct numbers, so that the columns share many
+		fmt.Fprintf(&b, "%d   %d\n", r.IntN(300)-100, r.IntN(200))
+	}
+	tests := []struct {
+		name, text string
+		budgets    []int
+	}{
+		{"example", example, []int{1, 4, DefaultBudget}},
+		{"random", b.String(), []int{97, 1000, DefaultBudget}},
+	}
+
+	for _, tt := range tests {
+		name, text := tt.name, tt.text
+		wantDistance, wantScore := solveInMemory(t, text)
+		for _, budget := range tt.budgets {
+			dir := t.TempDir()
+			distance, score, err := Stream(context.Background(), strings.NewReader(text), budget, dir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if distance != wantDistance || score != wantScore {
+				t.Errorf("%s, budget %d: Stream() = %d, %d, want %d, %d", name, budget, distance, score, wantDistance, wantScore)
+			}
+			if files, _ := os.ReadDir(dir); len(files) != 0 {
+				t.Errorf("%s, budget %d: %d run files left behind", name, budget, len(files))
+			}
+		}
+	}
+}
+
+func TestStreamErrors(t *testing.T) {
+	for _, text := range []string{"1 2\n\n3 4\n", "1 2\n3\n", "1 x\n"} {
+		if _, _, err := Stream(context.Background(), strings.NewReader(text), 1, t.TempDir()); err == nil {
+			t.Errorf("expected an error for %q", text)
+		}
+	}
+	if _, _, err := Stream(context.Background(), strings.NewReader("1 2\n3 4\n\n\n"), 1, t.TempDir()); err != nil {
+		t.Errorf("trailing empty lines: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	text := strings.Repeat("1 2\n", 10000)
+	if _, _, err := Stream(ctx, strings.NewReader(text), 100, t.TempDir()); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled Stream() error = %v", err)
+	}
+}
+
+// The similarity pass only holds the counts of the current number, however far one column is ahead of the other.
+// Holding the numbers of one column until the other catches up would allocate more for more lines.
+func TestSortedSimilaritySkewed(t *testing.T) {
+	allocs := make(map[int]float64)
+	for _, n := range []int{1000, 100000} {
+		// The left numbers are all below the right ones, but the last 10
+		left, right := newExternalSort(n, ""), newExternalSort(n, "")
+		var lefts, rights []int
+		for i := range n {
+			l, r := i, n-10+i
+			left.Add(l)
+			right.Add(r)
+			lefts, rights = append(lefts, l), append(rights, r)
+		}
+		want := calculateSimilarityScore(lefts, createFrequencyMap(rights))
+
+		allocs[n] = testing.AllocsPerRun(1, func() {
+			l, _ := left.Sorted()
+			r, _ := right.Sorted()
+			if got, err := sortedSimilarity(context.Background(), l, r); err != nil || got != want {
+				t.Errorf("%d lines: similarity = %d, %v, want %d", n, got, err, want)
+			}
+		})
+	}
+	if allocs[100000] > allocs[1000] {
+		t.Errorf("similarity pass allocated %v times for 100000 lines, but %v for 1000", allocs[100000], allocs[1000])
+	}
+}
@@ -0,0 +1,87 @@
+package day02
+
+import "slices"
+
+// SafetyRule says which reports are safe: the levels all increase, or all decrease, by MinStep to MaxStep at a time,
+// once at most MaxRemovals of them are removed (by the Problem Dampener).
+type SafetyRule struct {
+	MinStep, MaxStep int
+	MaxRemovals      int
+}
+
+// Rules of the puzzle
+var (
+	Strict   = SafetyRule{MinStep: 1, MaxStep: 3}                 // part I
+	Dampened = SafetyRule{MinStep: 1, MaxStep: 3, MaxRemovals: 1} // part II
+)
+
+// Check returns whether a report is safe, and the fewest levels to remove to make it so, by index.
+// It takes O(n*K) for n levels and K = MaxRemovals.
+func (rule SafetyRule) Check(report []int) (removed []int, safe bool) {
+	best := -1
+	for _, sign := range []int{1, -1} {
+		kept, ok := rule.longestRun(report, sign)
+		if ok && (best == -1 || len(report)-len(kept) < len(removed)) {
+			best, removed = len(kept), without(len(report), kept)
+		}
+	}
+	return removed, best != -1
+}
+
+// Finds the levels to keep, for a run going up (sign 1) or down (sign -1), removing as few as possible.
+// Keeping level i after level j removes the levels between them, so the fewest removals before a kept level i are
+//
+//	removals[i] = min(i, removals[j] + i-j-1), for the j from i-K-1 to i-1 with a valid step to i
+//
+// A level with more removals before it can't do better than one with fewer, so only the fewest are kept.
+func (rule SafetyRule) longestRun(report []int, sign int) (kept []int, ok bool) {
+	k := max(rule.MaxRemovals, 0)
+	n := len(report)
+	if n == 0 {
+		return nil, true
+	}
+
+	removals := make([]int, n)
+	prev := make([]int, n)
+	for i := range report {
+		removals[i], prev[i] = i, -1 // removing every level before
+		for j := i - 1; j >= max(0, i-k-1); j-- {
+			step := sign * (report[i] - report[j])
+			if step < rule.MinStep || step > rule.MaxStep {
+				continue
+			}
+			if r := removals[j] + i - j - 1; r < removals[i] {
+				removals[i], prev[i] = r, j
+			}
+		}
+	}
+
+	// The run ends on the level leaving the fewest removals, counting the levels after it
+	last := -1
+	for i := max(0, n-k-1); i < n; i++ {
+		if r := removals[i] + n - 1 - i; r <= k && (last == -1 || r < removals[last]+n-1-last) {
+			last = i
+		}
+	}
+	if last == -1 {
+		return nil, false
+	}
+	for i := last; i != -1; i = prev[i] {
+		kept = append(kept, i)
+	}
+	slices.Reverse(kept)
+	return kept, true
+}
+
+// The indices below n missing from the sorted kept ones
+func without(n int, kept []int) []int {
+	removed := []int{}
+	for i := range n {
+		if len(kept) > 0 && kept[0] == i {
+			kept = kept[1:]
+			continue
+		}
+		removed = append(removed, i)
+	}
+	return removed
+}
@@ -0,0 +1,67 @@
+package day02
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+func AbsInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Parse reads one report of levels per line.
+func Parse(r io.Reader) ([][]int, error) {
+	return input.ParseLines(r, parseReport)
+}
+
+// Parses the levels of a single report
+func parseReport(line string) ([]int, error) {
+	numStrs := input.Fields(line, "")
+	nums := make([]int, len(numStrs))
+
+	for i, numStr := range numStrs {
+		num, err := strconv.Atoi(numStr)
+		if err != nil {
+			return nil, fmt.Errorf("error converting '%s' to integer: %w", numStr, err)
+		}
+		nums[i] = num
+	}
+	return nums, nil
+}
+
+func hasChangedMonotonicity(diff int, is_decreasing bool) bool {
+	return (diff > 0 && !is_decreasing) || (diff < 0 && is_decreasing)
+}
+
+// Count the reports that are safe under rule
+func CountSafe(data [][]int, rule SafetyRule) int {
+	counter := 0
+	for _, row := range data {
+		if _, safe := rule.Check(row); safe {
+			counter++
+		}
+	}
+	return counter
+}
+
+// Count the rows that are safe without any help
+func Part1(ctx context.Context, data [][]int) (string, error) {
+	return strconv.Itoa(CountSafe(data, Strict)), nil
+}
+
+// Count the rows that are safe, allowing one element to be removed
+func Part2(ctx context.Context, data [][]int) (string, error) {
+	return strconv.Itoa(CountSafe(data, Dampened)), nil
+}
+
+func init() {
+	solver.Register(2, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,145 @@
+package day02
+
+import (
+	"context"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const example = `7 6 4 2 1
+1 2 7 8 9
+9 7 6 2 1
+1 3 2 4 5
+8 6 4 4 1
+1 3 6 7 9
+`
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, [][]int) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "2"},
+		{"part 2", Part2, "4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(data) != 6 {
+				t.Fatalf("parsed %d reports, want 6", len(data))
+			}
+
+			got, err := tt.part(context.Background(), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		row        []int
+		safe       bool  // without removing anything
+		safeRemove bool  // removing at most one level
+		removed    []int // by the dampener
+	}{
+		{[]int{7, 6, 4, 2, 1}, true, true, []int{}},
+		{[]int{1, 2, 7, 8, 9}, false, false, nil},
+		{[]int{1, 3, 2, 4, 5}, false, true, []int{1}},
+		{[]int{8, 6, 4, 4, 1}, false, true, []int{2}},
+		{[]int{5, 1, 2, 3, 4}, false, true, []int{0}}, // first level is the bad one
+		{[]int{1, 2, 3, 4, 9}, false, true, []int{4}}, // last level is the bad one
+	}
+
+	for _, tt := range tests {
+		if _, got := Strict.Check(tt.row); got != tt.safe {
+			t.Errorf("Strict.Check(%v) = %v, want %v", tt.row, got, tt.safe)
+		}
+		removed, got := Dampened.Check(tt.row)
+		if got != tt.safeRemove || !slices.Equal(removed, tt.removed) {
+			t.Errorf("Dampened.Check(%v) = %v, %v, want %v, %v", tt.row, removed, got, tt.removed, tt.safeRemove)
+		}
+	}
+
+	// Two faulty readings, and wider steps
+	rule := SafetyRule{MinStep: 1, MaxStep: 5, MaxRemovals: 2}
+	if removed, safe := rule.Check([]int{1, 50, 3, 60, 8}); !safe || !slices.Equal(removed, []int{1, 3}) {
+		t.Errorf("Check() = %v, %v, want [1 3], true", removed, safe)
+	}
+	if removed, safe := rule.Check([]int{4}); !safe || len(removed) != 0 {
+		t.Errorf("a single level = %v, %v, want safe", removed, safe)
+	}
+}
+
+// Tries every set of levels to remove, fewest first
+func bruteForce(rule SafetyRule, row []int) ([]int, bool) {
+	n := len(row)
+	best := -1
+	var bestRemoved []int
+	for mask := range 1 << n {
+		var kept, removed []int
+		for i := range n {
+			if mask&(1<<i) != 0 {
+				removed = append(removed, i)
+			} else {
+				kept = append(kept, row[i])
+			}
+		}
+		if len(removed) > rule.MaxRemovals || (best != -1 && len(removed) >= best) {
+			continue
+		}
+		for _, sign := range []int{1, -1} {
+			ok := true
+			for i := 1; i < len(kept); i++ {
+				if step := sign * (kept[i] - kept[i-1]); step < rule.MinStep || step > rule.MaxStep {
+					ok = false
+				}
+			}
+			if ok {
+				best, bestRemoved = len(removed), removed
+			}
+		}
+	}
+	return bestRemoved, best != -1
+}
+
+func TestCheckAgrees(t *testing.T) {
+	r := rand.New(rand.NewPCG(2, 4))
+	for range 3000 {
+		row := make([]int, 1+r.IntN(9))
+		for i := range row {
+			row[i] = r.IntN(12)
+		}
+		rule := SafetyRule{MinStep: r.IntN(2), MaxStep: 1 + r.IntN(4), MaxRemovals: r.IntN(4)}
+
+		removed, safe := rule.Check(row)
+		wantRemoved, wantSafe := bruteForce(rule, row)
+		if safe != wantSafe || len(removed) != len(wantRemoved) {
+			t.Fatalf("%+v.Check(%v) = %v, %v, want %v, %v", rule, row, removed, safe, wantRemoved, wantSafe)
+		}
+		if _, ok := (SafetyRule{rule.MinStep, rule.MaxStep, 0}).Check(dropIndices(row, removed)); safe && !ok {
+			t.Fatalf("%+v.Check(%v) removes %v, which leaves an unsafe report", rule, row, removed)
+		}
+	}
+}
+
+func dropIndices(row, indices []int) []int {
+	var res []int
+	for i, v := range row {
+		if !slices.Contains(indices, i) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,108 @@
+package day02
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// Ways a pair of levels breaks the safety rule
+const (
+	StepTooSmall  = "step too small"
+	StepTooLarge  = "step too large"
+	DirectionFlip = "direction flip" // the levels stop increasing, or decreasing
+)
+
+// Diagnosis explains why a report is unsafe, and how the dampener fixes it.
+// Levels are numbered from 0.
+type Diagnosis struct {
+	Report    int    `json:"report"` // line of the input, from 1
+	Safe      bool   `json:"safe"`
+	Pair      []int  `json:"pair,omitempty"`      // first two levels breaking the rule
+	Levels    []int  `json:"levels,omitempty"`    // their values
+	Violation string `json:"violation,omitempty"` // which rule they break
+	Fixable   bool   `json:"fixable"`             // safe once the dampener removes some levels
+	Remove    []int  `json:"remove,omitempty"`    // the fewest levels to remove for that
+}
+
+// Diagnose checks a report, the line-th of the input, against rule.
+// The report is safe if rule.Check keeps every level, otherwise the first pair of levels breaking the rule is looked for.
+func Diagnose(line int, row []int, rule SafetyRule) Diagnosis {
+	d := Diagnosis{Report: line}
+	d.Remove, d.Fixable = rule.Check(row)
+	if d.Fixable && len(d.Remove) == 0 {
+		return Diagnosis{Report: line, Safe: true}
+	}
+
+	decreasing := 0 // 1 or -1 once the first step that isn't flat gives the direction
+	for i := 0; i+1 < len(row); i++ {
+		diff := row[i] - row[i+1]
+		switch {
+		case AbsInt(diff) < rule.MinStep:
+			d.Violation = StepTooSmall
+		case AbsInt(diff) > rule.MaxStep:
+			d.Violation = StepTooLarge
+		case diff != 0 && decreasing != 0 && hasChangedMonotonicity(diff, decreasing > 0):
+			d.Violation = DirectionFlip
+		default:
+			if decreasing == 0 && diff != 0 {
+				decreasing = diff / AbsInt(diff)
+			}
+			continue
+		}
+		d.Pair, d.Levels = []int{i, i + 1}, []int{row[i], row[i+1]}
+		break
+	}
+	return d
+}
+
+// DiagnoseAll diagnoses every report.
+func DiagnoseAll(data [][]int, rule SafetyRule) []Diagnosis {
+	diagnoses := make([]Diagnosis, len(data))
+	for i, row := range data {
+		diagnoses[i] = Diagnose(i+1, row, rule)
+	}
+	return diagnoses
+}
+
+// WriteDiagnoses writes the diagnoses as JSON lines for format "jsonl", or as an aligned table for "table".
+func WriteDiagnoses(w io.Writer, diagnoses []Diagnosis, format string) error {
+	switch format {
+	case "jsonl":
+		enc := json.NewEncoder(w)
+		for _, d := range diagnoses {
+			if err := enc.Encode(d); err != nil {
+				return err
+			}
+		}
+		return nil
+
+	case "table":
+		tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(tw, "REPORT\tSTATUS\tPAIR\tLEVELS\tVIOLATION\tFIX")
+		for _, d := range diagnoses {
+			if d.Safe {
+				fmt.Fprintf(tw, "%d\tsafe\t-\t-\t-\t-\n", d.Report)
+				continue
+			}
+			fix := "-"
+			if d.Fixable {
+				fix = "remove " + joinInts(d.Remove)
+			}
+			fmt.Fprintf(tw, "%d\tunsafe\t%s\t%s\t%s\t%s\n", d.Report, joinInts(d.Pair), joinInts(d.Levels), d.Violation, fix)
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown format %q, should be jsonl or table", format)
+}
+
+func joinInts(nums []int) string {
+	strs := make([]string, len(nums))
+	for i, n := range nums {
+		strs[i] = strconv.Itoa(n)
+	}
+	return strings.Join(strs, ",")
+}
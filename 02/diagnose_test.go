@@ -0,0 +1,97 @@
+package day02
+
+import (
+	"bufio"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDiagnose(t *testing.T) {
+	data, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// As explained by the puzzle
+	want := []Diagnosis{
+		{Report: 1, Safe: true},
+		{Report: 2, Pair: []int{1, 2}, Levels: []int{2, 7}, Violation: StepTooLarge},
+		{Report: 3, Pair: []int{2, 3}, Levels: []int{6, 2}, Violation: StepTooLarge},
+		{Report: 4, Pair: []int{1, 2}, Levels: []int{3, 2}, Violation: DirectionFlip, Fixable: true, Remove: []int{1}},
+		{Report: 5, Pair: []int{2, 3}, Levels: []int{4, 4}, Violation: StepTooSmall, Fixable: true, Remove: []int{2}},
+		{Report: 6, Safe: true},
+	}
+	got := DiagnoseAll(data, Dampened)
+	for i := range want {
+		g, w := got[i], want[i]
+		if g.Report != w.Report || g.Safe != w.Safe || !slices.Equal(g.Pair, w.Pair) || !slices.Equal(g.Levels, w.Levels) ||
+			g.Violation != w.Violation || g.Fixable != w.Fixable || !slices.Equal(g.Remove, w.Remove) {
+			t.Errorf("report %d: got %+v, want %+v", i+1, g, w)
+		}
+	}
+
+	// Without a dampener nothing is fixable, and report 3 is safe with steps up to 4
+	if d := Diagnose(4, data[3], Strict); d.Fixable {
+		t.Errorf("Strict diagnosis %+v is fixable", d)
+	}
+	if d := Diagnose(3, data[2], SafetyRule{MinStep: 1, MaxStep: 4}); !d.Safe || d.Pair != nil {
+		t.Errorf("diagnosis with steps up to 4 = %+v, want safe", d)
+	}
+
+	// Flat steps are safe with MinStep 0, and don't give the direction
+	flat := SafetyRule{MinStep: 0, MaxStep: 3}
+	if d := Diagnose(1, []int{3, 3, 2, 1}, flat); !d.Safe || d.Pair != nil || d.Violation != "" {
+		t.Errorf("diagnosis with flat steps allowed = %+v, want safe", d)
+	}
+	d := Diagnose(1, []int{3, 3, 4, 2}, flat)
+	if d.Safe || !slices.Equal(d.Pair, []int{2, 3}) || d.Violation != DirectionFlip {
+		t.Errorf("diagnosis with flat steps allowed = %+v, want a direction flip at levels 2 and 3", d)
+	}
+	for _, row := range [][]int{{3, 3, 2, 1}, {3, 3, 4, 2}, {1, 1, 1}, {5, 4, 4, 6}} {
+		_, safe := flat.Check(row)
+		if d := Diagnose(1, row, flat); d.Safe != safe {
+			t.Errorf("%v: Diagnose says safe %v, Check says %v", row, d.Safe, safe)
+		}
+	}
+}
+
+func TestWriteDiagnoses(t *testing.T) {
+	data, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	diagnoses := DiagnoseAll(data, Dampened)
+
+	var out strings.Builder
+	if err := WriteDiagnoses(&out, diagnoses, "jsonl"); err != nil {
+		t.Fatal(err)
+	}
+	scanner := bufio.NewScanner(strings.NewReader(out.String()))
+	lines := 0
+	for ; scanner.Scan(); lines++ {
+		var d Diagnosis
+		if err := json.Unmarshal(scanner.Bytes(), &d); err != nil {
+			t.Fatalf("line %d: %v", lines+1, err)
+		}
+		if d.Report != lines+1 {
+			t.Errorf("line %d is report %d", lines+1, d.Report)
+		}
+	}
+	if lines != len(diagnoses) {
+		t.Errorf("%d JSON lines, want %d", lines, len(diagnoses))
+	}
+
+	out.Reset()
+	if err := WriteDiagnoses(&out, diagnoses, "table"); err != nil {
+		t.Fatal(err)
+	}
+	table := strings.Split(out.String(), "\n")
+	if len(table) != 8 || !strings.HasPrefix(table[0], "REPORT") || !strings.Contains(table[4], "direction flip  remove 1") {
+		t.Errorf("table =\n%s", out.String())
+	}
+	if err := WriteDiagnoses(&out, diagnoses, "xml"); err == nil {
+		t.Error("expected an error for an unknown format")
+	}
+}
@@ -0,0 +1,120 @@
+package day03
+
+import (
+	"context"
+	"fmt" // For printing
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+func AbsInt(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Reads the input, returns all lines joined into one string
+func Parse(r io.Reader) (string, error) {
+	return input.Text(r)
+}
+
+func parseMatches(matches [][]string) (int, error) {
+	var res int
+	for _, match := range matches {
+		if len(match) < 3 {
+			return 0, fmt.Errorf("Not a valid match, should have 3 groups")
+		}
+
+		first, err1 := strconv.Atoi(match[1])
+		second, err2 := strconv.Atoi(match[2])
+		if err1 != nil {
+			return 0, fmt.Errorf("Couldn't convert one of the operands to int: %v", err1)
+		}
+
+		if err2 != nil {
+			return 0, fmt.Errorf("Couldn't convert one of the operands to int: %v", err2)
+		}
+
+		res += first * second
+	}
+	return res, nil
+}
+
+// Part1 sums the results of all mul instructions.
+func Part1(ctx context.Context, data string) (string, error) {
+	var validMultiExpr = regexp.MustCompile(`mul\((\d+),(\d+)\)`)
+
+	// Parse each line and regex, return one array of all matches
+	matches := validMultiExpr.FindAllStringSubmatch(data, -1)
+
+	// Parsing matches, calculate the sum from all operations
+	res, err := parseMatches(matches)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(res), nil
+}
+
+// Given matched mul(int, int) statement, return the operation result
+func calculate_mul(match []string) (int, error) {
+	if len(match) != 3 {
+		return 0, fmt.Errorf("Not enough elements in found mul(int, int) match.")
+	}
+
+	first, err1 := strconv.Atoi(match[1])
+	second, err2 := strconv.Atoi(match[2])
+
+	if err1 != nil {
+		return 0, fmt.Errorf("Couldn't convert one of the operands to int: %v", err1)
+	}
+
+	if err2 != nil {
+		return 0, fmt.Errorf("Couldn't convert one of the operands to int: %v", err2)
+	}
+
+	return first * second, nil
+}
+
+// Part2 only sums the mul instructions enabled by do() and don't().
+func Part2(ctx context.Context, data string) (string, error) {
+	mulRegex := regexp.MustCompile(`mul\((\d+),(\d+)\)`)
+	dontRegex := regexp.MustCompile(`don't\(\)`)
+	doRegex := regexp.MustCompile(`do\(\)`)
+
+	result := 0
+	mulEnabled := true
+
+	// Find all tokens: {don't(), do() or mul(int, int)}
+	// Use state machine to determine whether to count found mul operations.
+	tokenRegex := regexp.MustCompile(`(?:(don't\(\))|(do\(\))|(mul\(\d+,\d+\)))`)
+	tokens := tokenRegex.FindAllString(data, -1)
+	for _, token := range tokens {
+		switch {
+		case dontRegex.MatchString(token):
+			mulEnabled = false
+		case doRegex.MatchString(token):
+			mulEnabled = true
+		case mulRegex.MatchString(token):
+			if mulEnabled {
+				match := mulRegex.FindStringSubmatch(token)
+				num, err := calculate_mul(match)
+				if err != nil {
+					return "", err
+				}
+				result += num
+			}
+		}
+	}
+
+	return strconv.Itoa(result), nil
+}
+
+func init() {
+	solver.Register(3, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,37 @@
+package day03
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name  string
+		part  func(context.Context, string) (string, error)
+		input string
+		want  string
+	}{
+		{"part 1", Part1, "xmul(2,4)%&mul[3,7]!@^do_not_mul(5,5)+mul(32,64]then(mul(11,8)mul(8,5))\n", "161"},
+		{"part 2", Part2, "xmul(2,4)&mul[3,7]!^don't()_mul(5,5)+mul(32,64](mul(11,8)undo()?mul(8,5))\n", "48"},
+		{"part 2 across lines", Part2, "don't()mul(1,1)\nmul(2,2)do()\nmul(3,3)\n", "9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,15 +1,19 @@
-package main
+package day04
 
 import (
-	"bufio"
 	"bytes"
+	"context"
 	"fmt"
-	"os"
+	"io"
+	"strconv"
 	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
 )
 
 // Crossword type, representing its grid
-type crossword [][]byte
+type Crossword [][]byte
 
 // Reverses a string
 func reverseString(str string) string {
@@ -21,7 +25,7 @@ func reverseString(str string) string {
 }
 
 // Provides a string representation of the crossword
-func (c crossword) String() string {
+func (c Crossword) String() string {
 	var builder strings.Builder
 
 	builder.WriteString("Crossword: \n")
@@ -36,31 +40,28 @@ func (c crossword) String() string {
 	return builder.String()
 }
 
-// Reads a crossword from a file
-func read_file(filename string) (crossword, error) {
-	// Open file
-	file, err := os.Open(filename)
+// Reads a crossword from the input
+func Parse(r io.Reader) (Crossword, error) {
+	lines, err := input.Lines(r)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
-
-	// Read line by line
-	var res crossword
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		byte_text := []byte(scanner.Text())
-		res = append(res, byte_text)
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("empty crossword")
 	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("Could not read file: %w", err)
+	res := make(Crossword, len(lines))
+	for i, line := range lines {
+		if len(line) != len(lines[0]) {
+			return nil, &input.LineError{Line: i + 1, Err: fmt.Errorf("expected %d letters, but got %d", len(lines[0]), len(line))}
+		}
+		res[i] = []byte(line)
 	}
 	return res, nil
 }
 
 // Find a string pattern in a crossword
-func findInCrossword(c crossword, text string) int {
+func findInCrossword(c Crossword, text string) int {
 	target := []byte(text)
 	targetRev := []byte(reverseString(text))
 	count := 0
@@ -119,7 +120,7 @@ func findInCrossword(c crossword, text string) int {
 	}
 
 	// Traverse diagonals (top-right -> bottom-left)
-	for col := cols - 1; col > len(text); col-- {
+	for col := cols - 1; col >= len(text)-1; col-- {
 		checkDiagonal(0, col, 1, -1)
 	}
 
@@ -137,7 +138,7 @@ M.S
 M.S
 Where each MAS on a diagonal, can be in either in normal or reversed direction.
 */
-func (c crossword) match() bool {
+func (c Crossword) match() bool {
 	// A has to be at the cetner, always
 	if c[1][1] != 'A' {
 		return false
@@ -150,13 +151,13 @@ func (c crossword) match() bool {
 }
 
 // Find X-MAS pattern in a crossworrd
-func findXMasInCrossword(c crossword) int {
+func findXMasInCrossword(c Crossword) int {
 	count := 0
 	rows, cols := len(c), len(c[0])
 
 	w, h := 3, 3
 	// Pre-initialise a 3x3 crossword pattern
-	var pattern crossword
+	var pattern Crossword
 	for i := 0; i < 3; i++ {
 		pattern = append(pattern, make([]byte, 3)) // Create a 3-byte slice for each row
 	}
@@ -176,16 +177,17 @@ func findXMasInCrossword(c crossword) int {
 	}
 	return count
 }
-func main() {
-	c, err := read_file("input.txt")
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
 
-	fmt.Println("Part I")
-	fmt.Println("Count: ", findInCrossword(c, "XMAS"))
+// Part I: count every XMAS in the crossword
+func Part1(ctx context.Context, c Crossword) (string, error) {
+	return strconv.Itoa(findInCrossword(c, "XMAS")), nil
+}
+
+// Part II: count every X-MAS in the crossword
+func Part2(ctx context.Context, c Crossword) (string, error) {
+	return strconv.Itoa(findXMasInCrossword(c)), nil
+}
 
-	fmt.Println("Part II")
-	fmt.Println("Count: ", findXMasInCrossword(c))
+func init() {
+	solver.Register(4, solver.New(Parse, Part1, Part2))
 }
@@ -0,0 +1,73 @@
+package day04
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const example = `MMMSXXMASM
+MSAMXMSMSA
+AMXSXMAAMM
+MSAMASMSMX
+XMASAMXAMM
+XXAMMXXAMA
+SMSMSASXSS
+SAXAMASAAA
+MAMMMXMMMM
+MXMXAXMASX
+`
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, Crossword) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "18"},
+		{"part 2", Part2, "9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFindInCrossword(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{"row", "XMAS\n....\n....\n....\n", 1},
+		{"column reversed", "S...\nA...\nM...\nX...\n", 1},
+		{"diagonal", "X...\n.M..\n..A.\n...S\n", 1},
+		{"anti-diagonal", "...X\n..M.\n.A..\nS...\n", 1},
+		{"anti-diagonal from the top row", ".....X\n....M.\n...A..\n..S...\n", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := findInCrossword(c, "XMAS"); got != tt.want {
+				t.Errorf("findInCrossword() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,68 +1,78 @@
-package main
+package day05
 
 import (
-	"bufio"
+	"context"
 	"fmt"
-	"os"
+	"io"
+	"log/slog"
 	"strconv"
 	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
 )
 
-func readInput(filename string) (map[int][]int, [][]int, error) {
-	file, err := os.Open(filename)
+func readInput(r io.Reader) (map[int][]int, [][]int, error) {
+	paragraphs, err := input.Paragraphs(r)
 	if err != nil {
-		return nil, nil, fmt.Errorf("Cannot read file: %w", err)
+		return nil, nil, err
+	}
+	// Rules come first, the updates after an empty line
+	if len(paragraphs) != 2 {
+		return nil, nil, fmt.Errorf("input should contain rules and updates separated by an empty line, but got %d paragraphs", len(paragraphs))
 	}
-	defer file.Close()
+	rulesPar, updatesPar := paragraphs[0], paragraphs[1]
 
-	scanner := bufio.NewScanner(file)
+	rules, err := input.Parse(rulesPar.Lines, rulesPar.Line, parseRule)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	// Create a map
 	orderMap := make(map[int][]int)
-	for scanner.Scan() {
-		line := scanner.Text()
-		// Empty lines represents the end of defining rules, moving to input
-		if line == "" {
-			break
-		}
+	for _, rule := range rules {
+		X, Y := rule[0], rule[1]
+		orderMap[Y] = append(orderMap[Y], X)
+	}
 
-		tokens := strings.Split(line, "|")
-		if len(tokens) != 2 {
-			return nil, nil, fmt.Errorf("Error reading the input, rule should be in a format 'int|int', but got: %v", line)
-		}
-		X, err1 := strconv.Atoi(tokens[0])
-		Y, err2 := strconv.Atoi(tokens[1])
-		if err1 != nil {
-			return nil, nil, fmt.Errorf("Error reading the input, rule should be in a format 'int|int', but got: %v, %w", line, err1)
-		}
-		if err2 != nil {
-			return nil, nil, fmt.Errorf("Error reading the input, rule should be in a format 'int|int', but got: %v, %w", line, err2)
-		}
+	updates, err := input.Parse(updatesPar.Lines, updatesPar.Line, parseUpdate)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		orderMap[Y] = append(orderMap[Y], X)
+	return orderMap, updates, nil
+}
+
+// Parses a rule 'X|Y', page X has to be printed before page Y
+func parseRule(line string) ([2]int, error) {
+	tokens := strings.Split(line, "|")
+	if len(tokens) != 2 {
+		return [2]int{}, fmt.Errorf("rule should be in a format 'int|int', but got: %v", line)
+	}
+	X, err1 := strconv.Atoi(tokens[0])
+	if err1 != nil {
+		return [2]int{}, fmt.Errorf("rule should be in a format 'int|int', but got: %v, %w", line, err1)
 	}
+	Y, err2 := strconv.Atoi(tokens[1])
+	if err2 != nil {
+		return [2]int{}, fmt.Errorf("rule should be in a format 'int|int', but got: %v, %w", line, err2)
+	}
+	return [2]int{X, Y}, nil
+}
 
-	// Read input
-	var input [][]int
-	for scanner.Scan() {
-		line := scanner.Text()
-		if line == "" {
-			return nil, nil, fmt.Errorf("Found an empty line in the input part, input should be a continous paragraph.")
-		}
-		tokens := strings.Split(line, ",")
+// Parses the pages of an update 'a,b,c'
+func parseUpdate(line string) ([]int, error) {
+	tokens := strings.Split(line, ",")
 
-		nums := make([]int, len(tokens))
-		for i, token := range tokens {
-			num, err := strconv.Atoi(token)
-			if err != nil {
-				return nil, nil, fmt.Errorf("Error converting one of the tokens to int: %w", err)
-			}
-			nums[i] = num
+	nums := make([]int, len(tokens))
+	for i, token := range tokens {
+		num, err := strconv.Atoi(token)
+		if err != nil {
+			return nil, fmt.Errorf("error converting one of the tokens to int: %w", err)
 		}
-		input = append(input, nums)
+		nums[i] = num
 	}
-
-	return orderMap, input, nil
+	return nums, nil
 }
 
 // m - num of rules
@@ -161,43 +171,53 @@ func partTwo(input []int, orderMap map[int][]int) []int {
 	return input
 }
 
-func main() {
-	// Read inputs, create map of rules
-	orderMap, input, err := readInput("input.txt")
+// Rules and updates from the input
+type PrintQueue struct {
+	OrderMap map[int][]int // pages that must come before each page
+	Updates  [][]int
+}
+
+// Parse reads the page ordering rules, and the updates.
+func Parse(r io.Reader) (PrintQueue, error) {
+	orderMap, updates, err := readInput(r)
 	if err != nil {
-		fmt.Println(err)
-		return
+		return PrintQueue{}, err
 	}
 
-	fmt.Println("Rules:")
-	for v, k := range orderMap {
-		fmt.Printf("%d | %v\n", k, v)
+	for page, before := range orderMap {
+		slog.Debug("ordering rule", "page", page, "after", before)
 	}
+	slog.Info("read print queue", "rules", len(orderMap), "updates", len(updates))
 
-	fmt.Println("Num of. Values: ", len(input))
-	fmt.Println()
-
-	// Incorrect inputs for the second part
-	var incorrect_inputs [][]int
+	return PrintQueue{orderMap, updates}, nil
+}
 
-	// Part I solution, O(n + m), assuming less rules than 'updates' -> O(n)
+// Part I solution, O(n + m), assuming less rules than 'updates' -> O(n)
+func Part1(ctx context.Context, q PrintQueue) (string, error) {
 	sum := 0
-	for _, line := range input {
-		if validateInput(line, orderMap) {
+	for _, line := range q.Updates {
+		if validateInput(line, q.OrderMap) {
 			middle_value := line[len(line)/2]
 			sum += middle_value
-		} else {
-			incorrect_inputs = append(incorrect_inputs, line)
 		}
 	}
+	return strconv.Itoa(sum), nil
+}
 
-	fmt.Println("Part I sum: ", sum)
-
-	sum = 0
-	for _, line := range incorrect_inputs {
-		fixed_line := partTwo(line, orderMap)
+// Part II, fix only the incorrect inputs
+func Part2(ctx context.Context, q PrintQueue) (string, error) {
+	sum := 0
+	for _, line := range q.Updates {
+		if validateInput(line, q.OrderMap) {
+			continue
+		}
+		fixed_line := partTwo(line, q.OrderMap)
 		middle_value := fixed_line[len(fixed_line)/2]
 		sum += middle_value
 	}
-	fmt.Println("Part II sum:", sum)
+	return strconv.Itoa(sum), nil
+}
+
+func init() {
+	solver.Register(5, solver.New(Parse, Part1, Part2))
 }
@@ -0,0 +1,85 @@
+package day05
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const example = `47|53
+97|13
+97|61
+97|47
+75|29
+61|13
+75|53
+29|13
+97|29
+53|29
+61|53
+97|53
+61|29
+47|13
+75|47
+97|75
+47|61
+75|61
+47|29
+75|13
+53|13
+
+75,47,61,53,29
+97,61,53,29,13
+75,29,13
+75,97,47,61,53
+61,13,29
+97,13,75,29,47
+`
+
+func TestReadInput(t *testing.T) {
+	orderMap, input, err := readInput(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(input) != 6 {
+		t.Errorf("parsed %d updates, want 6", len(input))
+	}
+
+	// Rules are stored under the page that has to come second
+	if before := orderMap[13]; !slices.Contains(before, 97) || !slices.Contains(before, 29) {
+		t.Errorf("orderMap[13] = %v, should contain 97 and 29", before)
+	}
+
+	if _, _, err := readInput(strings.NewReader("47-53\n\n1,2\n")); err == nil {
+		t.Error("expected an error for an invalid rule")
+	}
+}
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, PrintQueue) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "143"},
+		{"part 2", Part2, "123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package day06
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/06/guard"
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+func readInput(r io.Reader) (guard.GuardMap, int, int, error) {
+	// Read map, and find the guard
+	var start grid.Point
+	found := false
+	resultMap, err := grid.Parse(r, func(p grid.Point, char rune) (bool, error) {
+		if char == '^' {
+			start, found = p, true
+		}
+		return char == '#', nil
+	})
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("could not read map: %w", err)
+	}
+	if !found {
+		return nil, 0, 0, fmt.Errorf("no guard '^' found on the map")
+	}
+
+	return resultMap, start.X, start.Y, nil
+}
+
+// Guard's map and starting position
+type Lab struct {
+	Map  guard.GuardMap
+	X, Y int // starting position of the guard
+}
+
+// Parse reads the lab map, and the starting position of the guard.
+func Parse(r io.Reader) (Lab, error) {
+	guard_map, x_init, y_init, err := readInput(r)
+	if err != nil {
+		return Lab{}, err
+	}
+	return Lab{guard_map, x_init, y_init}, nil
+}
+
+// Part I: count the positions visited by the guard
+func Part1(ctx context.Context, l Lab) (string, error) {
+	g := guard.NewGuard(l.Map, l.X, l.Y, geom.Up)
+
+	_, count := g.TracePath()
+	return strconv.Itoa(count), nil
+}
+
+// Part II: count the wall placements that trap the guard in a loop
+func Part2(ctx context.Context, l Lab) (string, error) {
+	g := guard.NewGuard(l.Map, l.X, l.Y, geom.Up)
+	visited, _ := g.TracePath()
+
+	// Reset guard
+	count, err := g.CheckLoop(ctx, visited, l.X, l.Y, geom.Up)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(count), nil
+}
+
+// Animate draws every move of the guard, leaving a trail of the visited positions.
+func Animate(l Lab, a render.Sink) error {
+	g := guard.NewGuard(l.Map, l.X, l.Y, geom.Up)
+	moves := 0
+	g.OnMove = func(g *guard.Guard, visited guard.GuardMap) {
+		moves++
+		frame := render.GridFrame(l.Map, func(p grid.Point, wall bool) uint8 {
+			switch {
+			case wall:
+				return render.White
+			case visited.At(p):
+				return render.Grey
+			}
+			return render.Black
+		})
+		frame.Set(grid.Point{X: g.X, Y: g.Y}, render.Green)
+		frame.Label = fmt.Sprintf("move %d, facing %s", moves, g.Direction)
+		a.Add(frame)
+	}
+	g.TracePath()
+	return nil
+}
+
+func init() {
+	solver.Register(6, solver.New(Parse, Part1, Part2))
+}
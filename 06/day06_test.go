@@ -0,0 +1,64 @@
+package day06
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const example = `....#.....
+.........#
+..........
+..#.......
+.......#..
+..........
+.#..^.....
+........#.
+#.........
+......#...
+`
+
+func TestReadInput(t *testing.T) {
+	guardMap, x, y, err := readInput(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if x != 4 || y != 6 {
+		t.Errorf("guard found at (%d, %d), want (4, 6)", x, y)
+	}
+	if guardMap.Width() != 10 || guardMap.Height() != 10 {
+		t.Errorf("map is %dx%d, want 10x10", guardMap.Width(), guardMap.Height())
+	}
+
+	if _, _, _, err := readInput(strings.NewReader("....\n.#..\n")); err == nil {
+		t.Error("expected an error for a map without a guard")
+	}
+}
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, Lab) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "41"},
+		{"part 2", Part2, "6"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), l)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,3 +0,0 @@
-module day6
-
-go 1.23.3
@@ -1,71 +1,58 @@
 package guard
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"strconv"
 	"strings"
-)
-
-type GuardMap [][]bool
 
-// Deifne the direction map with (x, y) offsets
-type DirectionMap map[string][2]int
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
 
-var Directions DirectionMap = DirectionMap{
-	"NORTH": {0, -1},
-	"EAST":  {1, 0},
-	"SOUTH": {0, 1},
-	"WEST":  {-1, 0},
-}
+// GuardMap marks walls with true
+type GuardMap = *grid.Grid[bool]
 
 type Guard struct {
 	Map       GuardMap
 	X, Y      int
-	Direction string
+	Direction geom.Dir
+
+	// Called after every move of TracePath, e.g. to draw the guard
+	OnMove func(g *Guard, visited GuardMap)
 }
 
 // Move in the current direction (doesnt check bounds)
 func (g *Guard) MoveForward() {
-	offset := Directions[g.Direction]
-	g.X += offset[0]
-	g.Y += offset[1]
+	offset := g.Direction.Vec()
+	g.X += offset.X
+	g.Y += offset.Y
+}
+
+// Position in front of the guard
+func (g *Guard) ahead() grid.Point {
+	return grid.Point{X: g.X, Y: g.Y}.Add(g.Direction.Vec())
 }
 
 // Check if there is a wall in front of the guard
 func (g *Guard) IsWall() bool {
-	offset := Directions[g.Direction]
-	x, y := g.X+offset[0], g.Y+offset[1]
-	return g.Map[y][x]
+	return g.Map.At(g.ahead())
 }
 
 // Check if there is a border in front of guard
 func (g *Guard) IsBorder() bool {
-	max_x, max_y := len(g.Map[0]), len(g.Map)
-
-	offset := Directions[g.Direction]
-	x, y := g.X+offset[0], g.Y+offset[1]
-	return x < 0 || y < 0 || x >= max_x || y >= max_y
+	return !g.Map.In(g.ahead())
 }
 
 // Turn 90 degrees, clockwise
 func (g *Guard) Turn90CW() {
-	var nextDir string
-
-	switch g.Direction {
-	case "NORTH":
-		nextDir = "EAST"
-	case "EAST":
-		nextDir = "SOUTH"
-	case "SOUTH":
-		nextDir = "WEST"
-	case "WEST":
-		nextDir = "NORTH"
-	}
-
-	g.Direction = nextDir
+	g.Direction = g.Direction.CW()
 }
 
 // Constructor
-func NewGuard(map_arr GuardMap, x, y int, direction string) *Guard {
+func NewGuard(map_arr GuardMap, x, y int, direction geom.Dir) *Guard {
 	return &Guard{
 		Map:       map_arr,
 		X:         x,
@@ -74,27 +61,6 @@ func NewGuard(map_arr GuardMap, x, y int, direction string) *Guard {
 	}
 }
 
-// Stringer
-func (g GuardMap) String() string {
-	var builder strings.Builder
-
-	builder.WriteString("Map: \n")
-	for i, row := range g {
-		for _, item := range row {
-			if item {
-				builder.WriteString("#")
-			} else {
-				builder.WriteString(".")
-			}
-		}
-
-		if i < len(g)-1 {
-			builder.WriteString("\n")
-		}
-	}
-	return builder.String()
-}
-
 // Prints guard's current state (position, heading direction, etc.)
 func (g *Guard) String() string {
 	var builder strings.Builder
@@ -127,19 +93,20 @@ func (g *Guard) nextMove() (notFinished bool) {
 	}
 }
 
+// Walks the guard until it leaves the map, returns all visited positions (including the starting one) and their count
 func (g *Guard) TracePath() (GuardMap, int) {
 	// Initialise visited array
-	w, h := len(g.Map[0]), len(g.Map)
-	visited := make(GuardMap, h)
-	for i := range visited {
-		visited[i] = make([]bool, w)
-	}
+	visited := grid.New(g.Map.Width(), g.Map.Height(), false)
+	visited.Set(grid.Point{X: g.X, Y: g.Y}, true)
 
-	count := 0
+	count := 1
 	for g.nextMove() {
-		if !visited[g.Y][g.X] {
+		if pos := (grid.Point{X: g.X, Y: g.Y}); !visited.At(pos) {
 			count++
-			visited[g.Y][g.X] = true
+			visited.Set(pos, true)
+		}
+		if g.OnMove != nil {
+			g.OnMove(g, visited)
 		}
 	}
 
@@ -152,11 +119,11 @@ func (g *Guard) TracePath() (GuardMap, int) {
 // Stores all unique visited locations
 type posWithDir struct {
 	x, y int
-	dir  string
+	dir  geom.Dir
 }
 
 // Set guard's position and orientation
-func (g *Guard) set_guard(x_init, y_init int, dir_init string) {
+func (g *Guard) set_guard(x_init, y_init int, dir_init geom.Dir) {
 	g.X, g.Y, g.Direction = x_init, y_init, dir_init
 }
 
@@ -166,9 +133,10 @@ func (g *Guard) checkLoopHelper(x, y int) bool {
 	visited := make(map[posWithDir]struct{})
 
 	// Set x,y to wall for the execution.
-	g.Map[y][x] = true
+	wall := grid.Point{X: x, Y: y}
+	g.Map.Set(wall, true)
 	defer func() {
-		g.Map[y][x] = false
+		g.Map.Set(wall, false)
 	}()
 
 	// Traverse the map, if we're in the same position, with the same orientation, it's a loop!
@@ -184,24 +152,33 @@ func (g *Guard) checkLoopHelper(x, y int) bool {
 }
 
 // Counts the amount of possible wall locations, that create a loop.
-// Searches only the previously visited positions
-func (g *Guard) CheckLoop(visited GuardMap, x_init, y_init int, dir_init string) int {
+// Searches only the previously visited positions, except the guard's starting position
+// Reports its progress through ctx, and returns a solver.PartialError with the count so far once ctx is done
+func (g *Guard) CheckLoop(ctx context.Context, visited GuardMap, x_init, y_init int, dir_init geom.Dir) (int, error) {
 	count := 0
 
+	var candidates []grid.Point
+	for pos, wasVisited := range visited.All() {
+		if wasVisited && (pos.X != x_init || pos.Y != y_init) {
+			candidates = append(candidates, pos)
+		}
+	}
+	progress := solver.Track(ctx, len(candidates))
+
 	// For each visited position, check if adding wall will create a loop
-	for y, row := range g.Map {
-		for x := range row {
-			if visited[y][x] {
-
-				fmt.Println("Checking: ", x, y)
-				g.set_guard(x_init, y_init, dir_init)
-				if g.checkLoopHelper(x, y) {
-					count++
-				}
-			}
+	for _, pos := range candidates {
+		if err := ctx.Err(); err != nil {
+			return count, progress.Partial(strconv.Itoa(count), err)
+		}
+
+		slog.Debug("checking wall", "x", pos.X, "y", pos.Y)
+		g.set_guard(x_init, y_init, dir_init)
+		if g.checkLoopHelper(pos.X, pos.Y) {
+			count++
 		}
+		progress.Add(1)
 	}
-	return count
+	return count, nil
 }
 
 // Part II:
@@ -0,0 +1,167 @@
+package day07
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Operators maps an operator symbol to the function it applies
+type Operators map[byte]func(a, b int) int
+
+// Reads input for the problem
+// returns an array of results, and an array of expressions
+func readInput(r io.Reader) ([]int, [][]int, error) {
+	equations, err := input.ParseLines(r, parseEquation)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]int, len(equations))
+	expressions := make([][]int, len(equations))
+	for i, eq := range equations {
+		results[i], expressions[i] = eq[0], eq[1:]
+	}
+	return results, expressions, nil
+}
+
+// Parses 'result: a b c ...' into [result, a, b, c, ...]
+func parseEquation(line string) ([]int, error) {
+	split := strings.Split(line, ": ")
+	if len(split) != 2 {
+		return nil, fmt.Errorf("incorrect input, should be in format 'int: int int int ...', but got: '%v'", line)
+	}
+	operands := strings.Split(split[1], " ")
+	if len(operands) < 2 {
+		return nil, fmt.Errorf("incorrect input, should be in format 'int: int int int ...', but got: '%v'", line)
+	}
+
+	// Convert to integers
+	eq := make([]int, 0, len(operands)+1)
+	for _, num := range append([]string{split[0]}, operands...) {
+		n, err := strconv.Atoi(num)
+		if err != nil {
+			return nil, fmt.Errorf("incorrect input, should be in format 'int: int int int ...', but got: '%v'", line)
+		}
+		eq = append(eq, n)
+	}
+	return eq, nil
+}
+
+// Recursively generates an array of all operator combinations of length n
+func generateCombinations(byteOperatorMap Operators, n int) []string {
+	if n == 0 {
+		return []string{""}
+	}
+
+	smallerCombinations := generateCombinations(byteOperatorMap, n-1)
+	result := []string{}
+
+	for _, combination := range smallerCombinations {
+		for op := range byteOperatorMap {
+			result = append(result, combination+string(op))
+		}
+	}
+	return result
+}
+
+// Calculates the expression for every possible combination of operators.
+// Returns the expression value if it matches the expected result, otherwise returns 0
+func checkExpression(byteOperatorMap Operators, expectedRes int, expr []int) int {
+	opCombinations := generateCombinations(byteOperatorMap, len(expr)-1)
+
+	for _, operators := range opCombinations {
+		res := expr[0]
+		for i, op := range operators {
+			res = byteOperatorMap[byte(op)](res, expr[i+1])
+
+			// Exit when exceeded expected result
+			if res > expectedRes {
+				break
+			}
+		}
+		if res == expectedRes {
+			return res
+		}
+	}
+	return 0
+}
+
+// Part I operators
+var AddMul = Operators{
+	'+': func(a, b int) int { return a + b },
+	'*': func(a, b int) int { return a * b },
+}
+
+// Part II operators, with || concatenating the digits
+var AddMulConcat = Operators{
+	'+': func(a, b int) int { return a + b },
+	'*': func(a, b int) int { return a * b },
+	'|': func(a, b int) int {
+		placeValue := 1
+		for b >= placeValue {
+			placeValue *= 10
+		}
+		return a*placeValue + b
+	},
+}
+
+// Expected results, and their expressions
+type Calibrations struct {
+	Results []int
+	Exprs   [][]int
+}
+
+// Parse reads the calibration equations.
+func Parse(r io.Reader) (Calibrations, error) {
+	results, exprs, err := readInput(r)
+	if err != nil {
+		return Calibrations{}, err
+	}
+	return Calibrations{results, exprs}, nil
+}
+
+/*
+Given n operands in an expression and, m operators, given i expressions.
+For each expression:
+- Have to run (n-1) operations, for m^(n-1) combinations.
+- So complexity is O(n*m^n), without || opperator
+Part I: (2 operators, n-1 operations, i expressions), assuming less operands than expressions
+O(i^2 * 2^i) -> O(n^2 * 2^n)
+Memory: O(2^n), we store all the combinations
+
+Part II: operator || takes log10(b) number, that depened on the biggest number in array
+O(n^2 * 3^n * log(b)), where b is the biggest number in array
+Memory: O(3^n), we store all the combination
+
+Speed up possibilities:
+Work backwards with / and - instead, dropping when number is not divisible / get a negative.
+Reversing || is not so straight forward i guess.
+*/
+// Calculates the sum of expression results, that match the expected result
+func Solve(c Calibrations, ops Operators) int {
+	sum := 0
+	for i, expr := range c.Exprs {
+		sum += checkExpression(ops, c.Results[i], expr)
+	}
+	return sum
+}
+
+// Part1 sums the results that can be made with + and *.
+func Part1(ctx context.Context, c Calibrations) (string, error) {
+	return strconv.Itoa(Solve(c, AddMul)), nil
+}
+
+// Part2 also allows concatenating the numbers with ||.
+func Part2(ctx context.Context, c Calibrations) (string, error) {
+	return strconv.Itoa(Solve(c, AddMulConcat)), nil
+}
+
+func init() {
+	solver.Register(7, solver.New(Parse, Part1, Part2))
+}
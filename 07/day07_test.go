@@ -0,0 +1,66 @@
+package day07
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const example = `190: 10 19
+3267: 81 40 27
+83: 17 5
+156: 15 6
+7290: 6 8 6 15
+161011: 16 10 13
+192: 17 8 14
+21037: 9 7 18 13
+292: 11 6 16 20
+`
+
+func TestReadInput(t *testing.T) {
+	results, exprs, err := readInput(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 9 || len(exprs) != 9 {
+		t.Fatalf("parsed %d results and %d expressions, want 9", len(results), len(exprs))
+	}
+	if results[1] != 3267 || !slices.Equal(exprs[1], []int{81, 40, 27}) {
+		t.Errorf("second equation = %d: %v", results[1], exprs[1])
+	}
+
+	for _, line := range []string{"190 10 19", "190: 10", "x: 1 2"} {
+		if _, _, err := readInput(strings.NewReader(line)); err == nil {
+			t.Errorf("expected an error for %q", line)
+		}
+	}
+}
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, Calibrations) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "3749"},
+		{"part 2", Part2, "11387"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
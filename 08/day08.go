@@ -0,0 +1,147 @@
+package day08
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+type position = grid.Point
+
+// Parses the input, returns the map, and for each character an array of all position where they were found
+func readFile(r io.Reader) (*grid.Grid[byte], map[byte][]position, error) {
+	cityMap, err := input.Grid(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error reading input: %w", err)
+	}
+
+	res := make(map[byte][]position)
+	for pos, char := range cityMap.All() {
+		// Add each character to a map
+		if char == '.' {
+			continue
+		}
+		res[char] = append(res[char], pos)
+	}
+
+	return cityMap, res, nil
+}
+
+// Returns two antinodes of two antennas, according to frequency rules (part I)
+func pointsOnLine(pos1, pos2 position, cityMap *grid.Grid[byte]) []position {
+	diff := pos2.Sub(pos1)
+
+	points := []position{
+		pos1.Sub(diff),
+		pos2.Add(diff),
+	}
+
+	var pointsFiltered []position
+	for _, pos := range points {
+		if cityMap.In(pos) {
+			pointsFiltered = append(pointsFiltered, pos)
+		}
+	}
+	return pointsFiltered
+}
+
+// Returns two antinodes of two antennas, according to frequency rules (part II)
+func pointsOnLine2(pos1, pos2 position, cityMap *grid.Grid[byte]) []position {
+	diff := pos2.Sub(pos1)
+
+	// Iterate through points on a line, defined by the input pair of points, one loop for each direction (positive and negative)
+	var points []position
+	for point := pos1; cityMap.In(point); point = point.Sub(diff) {
+		points = append(points, point)
+	}
+
+	for point := pos2; cityMap.In(point); point = point.Add(diff) {
+		points = append(points, point)
+	}
+
+	return points
+}
+
+// Given an array of T, return an array of all possible pairs (permutations)
+func getPairs[T any](values []T) [][2]T {
+	var pairs [][2]T
+	n := len(values)
+	for i := 0; i < n; i++ {
+		for j := i + 1; j < n; j++ {
+			pairs = append(pairs, [2]T{values[i], values[j]})
+		}
+	}
+	return pairs
+}
+
+// Antennas, and the map they're on
+type City struct {
+	Map      *grid.Grid[byte]
+	Antennas map[byte][]position
+}
+
+// Parse reads the city map, and groups the antennas by frequency.
+func Parse(r io.Reader) (City, error) {
+	cityMap, antennas, err := readFile(r)
+	if err != nil {
+		return City{}, err
+	}
+	return City{cityMap, antennas}, nil
+}
+
+// Logs the map with the antinodes marked by '#', when debugging
+func logAntinodes(ctx context.Context, c City, antinodes map[position]struct{}) {
+	if !slog.Default().Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	marked := c.Map.Clone()
+	for point := range antinodes {
+		marked.Set(point, '#')
+	}
+	slog.DebugContext(ctx, "antinodes", "map", "\n"+marked.String())
+}
+
+// Part1 counts the antinodes at twice the distance between two antennas.
+func Part1(ctx context.Context, c City) (string, error) {
+	uniquePointsSet := make(map[position]struct{})
+	for _, v := range c.Antennas {
+		pairs := getPairs(v)
+		for _, pair := range pairs {
+			new_points := pointsOnLine(pair[0], pair[1], c.Map)
+			for _, point := range new_points {
+				uniquePointsSet[point] = struct{}{}
+			}
+		}
+	}
+
+	logAntinodes(ctx, c, uniquePointsSet)
+	return strconv.Itoa(len(uniquePointsSet)), nil
+}
+
+// Part2 counts every position in line with two antennas.
+func Part2(ctx context.Context, c City) (string, error) {
+	uniquePointsSet := make(map[position]struct{})
+	for _, v := range c.Antennas {
+		pairs := getPairs(v)
+		for _, pair := range pairs {
+			new_points := pointsOnLine2(pair[0], pair[1], c.Map)
+			for _, point := range new_points {
+				uniquePointsSet[point] = struct{}{}
+			}
+		}
+	}
+
+	logAntinodes(ctx, c, uniquePointsSet)
+	return strconv.Itoa(len(uniquePointsSet)), nil
+}
+
+func init() {
+	solver.Register(8, solver.New(Parse, Part1, Part2))
+}
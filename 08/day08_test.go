@@ -0,0 +1,62 @@
+package day08
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+const example = `............
+........0...
+.....0......
+.......0....
+....0.......
+......A.....
+............
+............
+........A...
+.........A..
+............
+............
+`
+
+func TestReadFile(t *testing.T) {
+	cityMap, antennas, err := readFile(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cityMap.Width() != 12 || cityMap.Height() != 12 {
+		t.Errorf("map is %dx%d, want 12x12", cityMap.Width(), cityMap.Height())
+	}
+	if len(antennas['0']) != 4 || len(antennas['A']) != 3 {
+		t.Errorf("found %d '0' and %d 'A' antennas, want 4 and 3", len(antennas['0']), len(antennas['A']))
+	}
+}
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, City) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "14"},
+		{"part 2", Part2, "34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), c)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,3 +0,0 @@
-module day8
-
-go 1.23.3
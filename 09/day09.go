@@ -0,0 +1,33 @@
+package day09
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/09/file_operations"
+	"github.com/SpicyHolo/advent_of_code_2024/09/input"
+	"github.com/SpicyHolo/advent_of_code_2024/09/memory"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Parse reads the disk map, and expands it into a block per position.
+func Parse(r io.Reader) ([]input.Status, error) {
+	return input.ParseInput(r)
+}
+
+// Part I: move single blocks to the leftmost free space
+func Part1(ctx context.Context, data []input.Status) (string, error) {
+	memory.FillEmpty(data)
+	return strconv.Itoa(file_operations.CheckSum(data)), nil
+}
+
+// Part II: move whole files to the leftmost free space that fits them
+func Part2(ctx context.Context, data []input.Status) (string, error) {
+	file_operations.FillEmpty2(data)
+	return strconv.Itoa(file_operations.CheckSum(data)), nil
+}
+
+func init() {
+	solver.Register(9, solver.New(Parse, Part1, Part2))
+}
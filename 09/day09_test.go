@@ -0,0 +1,39 @@
+package day09
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/09/input"
+)
+
+const example = "2333133121414131402\n"
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, []input.Status) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "1928"},
+		{"part 2", Part2, "2858"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := input.ParseInput(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), data)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,9 +1,10 @@
 package file_operations
 
 import (
-	"day9/input"
 	"slices"
 	"sort"
+
+	"github.com/SpicyHolo/advent_of_code_2024/09/input"
 )
 
 // input.Status type, for memory status
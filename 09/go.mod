@@ -1,3 +0,0 @@
-module day9
-
-go 1.23.3
@@ -1,10 +1,11 @@
 package input
 
 import (
-	"bufio"
 	"fmt"
-	"os"
+	"io"
 	"strconv"
+
+	aocinput "github.com/SpicyHolo/advent_of_code_2024/input"
 )
 
 // Status type, for memory status
@@ -22,18 +23,11 @@ func (s Status) String() string {
 }
 
 // Parsing input
-func ParseInput(filename string) ([]Status, error) {
-	file, err := os.Open(filename)
+func ParseInput(r io.Reader) ([]Status, error) {
+	// Read data, the whole disk map is a single line
+	data, err := aocinput.Text(r)
 	if err != nil {
-		return nil, fmt.Errorf("error could not read file: %w", err)
-	}
-
-	// Read data
-	scanner := bufio.NewScanner(file)
-	scanner.Scan()
-	data := scanner.Text()
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error reading file: %w", err)
+		return nil, err
 	}
 
 	// Parse data
@@ -41,7 +35,10 @@ func ParseInput(filename string) ([]Status, error) {
 	isFile := true
 	var res []Status
 
-	for _, char := range data {
+	for i, char := range data {
+		if char < '0' || char > '9' {
+			return nil, fmt.Errorf("position %d: expected a digit, but got %q", i+1, char)
+		}
 		num := int(char - '0')
 		if isFile {
 			for i := 0; i < num; i++ {
@@ -1,8 +1,9 @@
 package memory
 
 import (
-	"day9/input"
 	"slices"
+
+	"github.com/SpicyHolo/advent_of_code_2024/09/input"
 )
 
 // FillEmpty moves all files in memory to the left to fill empty spaces
@@ -0,0 +1,115 @@
+package day10
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/search"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Stores 2d coordinates
+type pos = grid.Point
+
+// Loads input from a reader
+func Parse(r io.Reader) (*grid.Grid[uint8], error) {
+	heightMap, err := grid.Parse(r, func(_ pos, char rune) (uint8, error) {
+		if char < '0' || char > '9' {
+			return 0, fmt.Errorf("invalid height %q", char)
+		}
+		return uint8(char - '0'), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not read input: %w", err)
+	}
+	return heightMap, nil
+}
+
+// Get all valid adjacent positions in heightMap, according to trail rules
+/*
+	The height difference between them should be 1
+*/
+func getValidAdjacent(heightMap *grid.Grid[uint8], position pos, diff uint8) []pos {
+	var adjNodes []pos
+
+	for adjNode := range heightMap.Neighbours4(position) {
+		// Check height difference
+		if currDiff := heightMap.At(adjNode) - heightMap.At(position); currDiff != diff {
+			continue
+		}
+
+		adjNodes = append(adjNodes, adjNode)
+	}
+
+	return adjNodes
+}
+
+// Steps up a trail, to the adjacent positions one higher
+func uphill(heightMap *grid.Grid[uint8]) search.Neighbours[pos] {
+	return func(position pos) []search.Edge[pos] {
+		return search.Unit(getValidAdjacent(heightMap, position, 1)...)
+	}
+}
+
+// using BFS, counts the number of trails, from starting position
+/*
+	Part I
+	Unique trails are defined as trails with unique destination.
+*/
+func countUniqueDestTrails(heightMap *grid.Grid[uint8], start pos) int {
+	numPaths := 0
+	for node := range search.BFS(start, uphill(heightMap), nil).Dist {
+		// Check if reached the end of path (height == 9)
+		if heightMap.At(node) == 9 {
+			numPaths++
+		}
+	}
+	return numPaths
+}
+
+// counts the number of trails, from starting position
+/*
+	Part II
+	Here unique trails are defined, as the list of traversed nodes (so one destination can have multiple unique trails)
+	Every step climbs by one, so every trail to a peak is one of the shortest paths to it.
+*/
+func countUniqueTrails(heightMap *grid.Grid[uint8], start pos) int {
+	trails := search.AllShortestPathsDAG(start, uphill(heightMap), nil)
+
+	numPaths := 0
+	for node := range trails.Dist {
+		if heightMap.At(node) == 9 {
+			numPaths += trails.Count(node)
+		}
+	}
+	return numPaths
+}
+
+// Finds all trailhead values, for each finds the number of unique trails, and accumulates the result
+func countPathsAtTrailheads(heightMap *grid.Grid[uint8], trailheadValue uint8, countTrails func(heightMap *grid.Grid[uint8], start pos) int) int {
+	sum := 0
+	for _, start := range heightMap.FindAll(trailheadValue) {
+		sum += countTrails(heightMap, start)
+	}
+
+	return sum
+}
+
+// Part1 sums the number of peaks reachable from each trailhead.
+func Part1(ctx context.Context, heightMap *grid.Grid[uint8]) (string, error) {
+	sum := countPathsAtTrailheads(heightMap, 0, countUniqueDestTrails)
+	return strconv.Itoa(sum), nil
+}
+
+// Part2 sums the number of distinct trails from each trailhead.
+func Part2(ctx context.Context, heightMap *grid.Grid[uint8]) (string, error) {
+	sum := countPathsAtTrailheads(heightMap, 0, countUniqueTrails)
+	return strconv.Itoa(sum), nil
+}
+
+func init() {
+	solver.Register(10, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,53 @@
+package day10
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+)
+
+const example = `89010123
+78121874
+87430965
+96549874
+45678903
+32019012
+01329801
+10456732
+`
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, *grid.Grid[uint8]) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "36"},
+		{"part 2", Part2, "81"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			heightMap, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), heightMap)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadInput(t *testing.T) {
+	if _, err := Parse(strings.NewReader("0123\n01x3\n")); err == nil {
+		t.Error("expected an error for a non digit height")
+	}
+}
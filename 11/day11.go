@@ -1,14 +1,18 @@
-package main
+package day11
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"math"
 	"strconv"
-	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
 )
 
-func parseInput(input string) ([]int, error) {
-	split := strings.Split(input, " ")
+func parseInput(data string) ([]int, error) {
+	split := input.Fields(data, "")
 	numbers := make([]int, len(split))
 	for i, num := range split {
 		numInt, err := strconv.Atoi(num)
@@ -86,24 +90,33 @@ func blinkNTimes(initStones []int, n_steps int) (int, error) {
 	return totalStones, nil
 }
 
-func main() {
-	input := "92 0 286041 8034 34394 795 8 2051489"
-	stones, err := parseInput(input)
+// Reads the stones line, and parses it
+func Parse(r io.Reader) ([]int, error) {
+	data, err := input.Text(r)
 	if err != nil {
-		fmt.Println(err)
-		return
+		return nil, err
 	}
-	count1, err := blinkNTimes(stones, 25)
+	return parseInput(data)
+}
+
+// Part1 counts the stones after blinking 25 times.
+func Part1(ctx context.Context, stones []int) (string, error) {
+	count, err := blinkNTimes(stones, 25)
 	if err != nil {
-		fmt.Println(err)
-		return
+		return "", err
 	}
-	fmt.Println("Part I: ", count1)
+	return strconv.Itoa(count), nil
+}
 
-	count2, err := blinkNTimes(stones, 75)
+// Part2 counts the stones after blinking 75 times.
+func Part2(ctx context.Context, stones []int) (string, error) {
+	count, err := blinkNTimes(stones, 75)
 	if err != nil {
-		fmt.Println(err)
-		return
+		return "", err
 	}
-	fmt.Println("Part II: ", count2)
+	return strconv.Itoa(count), nil
+}
+
+func init() {
+	solver.Register(11, solver.New(Parse, Part1, Part2))
 }
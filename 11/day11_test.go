@@ -0,0 +1,45 @@
+package day11
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestBlinkNTimes(t *testing.T) {
+	tests := []struct {
+		stones []int
+		n      int
+		want   int
+	}{
+		{[]int{0, 1, 10, 99, 999}, 1, 7},
+		{[]int{125, 17}, 1, 3},
+		{[]int{125, 17}, 6, 22},
+		{[]int{125, 17}, 25, 55312},
+	}
+
+	for _, tt := range tests {
+		got, err := blinkNTimes(tt.stones, tt.n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("blinkNTimes(%v, %d) = %d, want %d", tt.stones, tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestPart1(t *testing.T) {
+	stones, err := Parse(strings.NewReader("125 17\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Part1(context.Background(), stones)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "55312" {
+		t.Errorf("got %s, want 55312", got)
+	}
+}
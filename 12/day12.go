@@ -0,0 +1,179 @@
+package day12
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+type Position = geom.Vec
+
+// A fence piece: the position outside the region, and the side of the region it is on
+type PositionWithDir struct {
+	pos Position
+	dir geom.Dir
+}
+
+// Implement a set on a map
+type Set[T comparable] map[T]struct{}
+
+func (s Set[T]) add(element T) {
+	s[element] = struct{}{}
+}
+
+func (s Set[T]) remove(element T) {
+	delete(s, element)
+}
+
+func (s Set[T]) contains(element T) bool {
+	_, exists := s[element]
+	return exists
+}
+
+func (s Set[T]) copy() Set[T] {
+	// Create a new map with the same type and initial capacity
+	copy := make(Set[T], len(s))
+
+	// Copy each key-value pair from the original map to the new map
+	for key := range s {
+		copy.add(key)
+	}
+
+	return copy
+}
+
+// String provides a string representation of the set.
+func (s Set[T]) String() string {
+	var builder strings.Builder
+	builder.WriteString("{")
+	first := true
+	for element := range s {
+		if !first {
+			builder.WriteString(", ")
+		}
+		builder.WriteString(fmt.Sprint(element))
+		first = false
+	}
+	builder.WriteString("}")
+	return builder.String()
+}
+
+// Parse reads the map of garden plots.
+func Parse(r io.Reader) (*grid.Grid[byte], error) {
+	gardenMap, err := input.Grid(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read input: %w", err)
+	}
+	return gardenMap, nil
+}
+
+// Flood fill and mark all connnected cells within the same character region
+func exploreSegment(gardenMap *grid.Grid[byte], pos Position, segmentID int, segments map[Position]int) {
+	for _, regionPos := range gardenMap.Region(pos) {
+		segments[regionPos] = segmentID
+	}
+}
+
+// Find all connected segments, returns a map from all positions to segmentID
+func findConnectedSegments(gardenMap *grid.Grid[byte]) map[Position]int {
+	segments := make(map[Position]int)
+	segmentID := 0
+
+	// Iterate over all position in the map
+	for pos := range gardenMap.All() {
+		// Skip already visited positions
+		if _, visited := segments[pos]; visited {
+			continue
+		}
+
+		// Start a new exploration on this position
+		exploreSegment(gardenMap, pos, segmentID, segments)
+		segmentID++
+	}
+	return segments
+}
+
+// Given a Set of positions in a segment, find its perimeter
+// By doing a dfs, and adding all neighhbors that are not in the set already
+func findPerimeter(segment Set[Position]) Set[PositionWithDir] {
+	perimeter := make(Set[PositionWithDir])
+	for pos := range segment {
+		// All four neighbors, including the ones outside the map
+		for _, dir := range geom.Dirs {
+			neighbor := pos.Add(dir.Vec())
+			// Skip already visited positions
+			if segment.contains(neighbor) {
+				continue
+			}
+
+			perimeter.add(PositionWithDir{neighbor, dir})
+		}
+	}
+	return perimeter
+}
+
+func findLines(perimeter Set[PositionWithDir]) Set[PositionWithDir] {
+	dirs := []Position{geom.Right.Vec(), geom.Down.Vec()}
+	copy := perimeter.copy()
+
+	for posWithDir := range perimeter {
+		for _, dp := range dirs {
+			next := PositionWithDir{posWithDir.pos.Add(dp), posWithDir.dir}
+			if perimeter.contains(next) {
+				copy.remove(posWithDir)
+			}
+		}
+	}
+	return copy
+}
+
+// Calculates the fence price for every segment, using its perimeter (part I) and number of sides (part II)
+func fencePrices(gardenMap *grid.Grid[byte]) (int, int) {
+	// Find connected components
+	cc := findConnectedSegments(gardenMap)
+
+	// Get a Set containing each segment
+	var segments []Set[Position]
+	for pos, segmentID := range cc {
+		for segmentID >= len(segments) {
+			segments = append(segments, make(Set[Position]))
+		}
+
+		segments[segmentID].add(pos)
+	}
+
+	sum_part1 := 0
+	sum_part2 := 0
+	for _, segment := range segments {
+		perimeter_set := findPerimeter(segment)
+		line_set := findLines(perimeter_set)
+
+		sum_part1 += len(segment) * len(perimeter_set)
+		sum_part2 += len(segment) * len(line_set)
+	}
+
+	return sum_part1, sum_part2
+}
+
+// Part1 prices the fences by area times perimeter.
+func Part1(ctx context.Context, gardenMap *grid.Grid[byte]) (string, error) {
+	price, _ := fencePrices(gardenMap)
+	return strconv.Itoa(price), nil
+}
+
+// Part2 prices the fences by area times number of sides.
+func Part2(ctx context.Context, gardenMap *grid.Grid[byte]) (string, error) {
+	_, price := fencePrices(gardenMap)
+	return strconv.Itoa(price), nil
+}
+
+func init() {
+	solver.Register(12, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,44 @@
+package day12
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFencePrices(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		p1, p2 int
+	}{
+		{"small", "AAAA\nBBCD\nBBCC\nEEEC\n", 140, 80},
+		{"nested", "OOOOO\nOXOXO\nOOOOO\nOXOXO\nOOOOO\n", 772, 436},
+		{"E shape", "EEEEE\nEXXXX\nEEEEE\nEXXXX\nEEEEE\n", 692, 236},
+		{"diagonal touch", "AAAAAA\nAAABBA\nAAABBA\nABBAAA\nABBAAA\nAAAAAA\n", 1184, 368},
+		{"larger", `RRRRIICCFF
+RRRRIICCCF
+VVRRRCCFFF
+VVRCCCJFFF
+VVVVCJJCFE
+VVIVCCJJEE
+VVIIICJJEE
+MIIIIIJJEE
+MIIISIJEEE
+MMMISSJEEE
+`, 1930, 1206},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gardenMap, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p1, p2 := fencePrices(gardenMap)
+			if p1 != tt.p1 || p2 != tt.p2 {
+				t.Errorf("fencePrices() = %d, %d, want %d, %d", p1, p2, tt.p1, tt.p2)
+			}
+		})
+	}
+}
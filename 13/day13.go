@@ -1,28 +1,21 @@
-package main
+package day13
 
 import (
-	"bufio"
+	"context"
 	"fmt"
+	"io"
+	"log/slog"
 	"math"
-	"os"
 	"regexp"
 	"strconv"
 	"strings"
-)
-
-type Position struct {
-	X, Y int
-}
 
-func (p Position) String() string {
-	var builder strings.Builder
-	fmt.Fprintf(&builder, "(%v, %v)", p.X, p.Y)
-	return builder.String()
-}
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
 
-func (p1 Position) Add(p2 Position) Position {
-	return Position{X: p1.X + p2.X, Y: p1.Y + p2.Y}
-}
+type Position = geom.Vec
 
 type Game struct {
 	ButtonA, ButtonB, PrizePos Position
@@ -56,68 +49,55 @@ func parsePositionRegex(matches [][]string) (Position, error) {
 	return Position{X: x, Y: y}, nil
 }
 
-func loadInput(filename string, part2 bool) ([]Game, error) {
-	file, err := os.Open(filename)
+func parseGame(lines []string) (Game, error) {
+	reButton := regexp.MustCompile(`Button [AB]: X\+(\d+), Y\+(\d+)`)
+	rePrize := regexp.MustCompile(`Prize: X=(\d+), Y=(\d+)`)
+
+	match_a := reButton.FindAllStringSubmatch(lines[0], -1)
+	match_b := reButton.FindAllStringSubmatch(lines[1], -1)
+	match_prize := rePrize.FindAllStringSubmatch(lines[2], -1)
+
+	button_a, err1 := parsePositionRegex(match_a)
+	if err1 != nil {
+		return Game{}, fmt.Errorf("failed to parse button A: %w", err1)
+	}
+
+	button_b, err2 := parsePositionRegex(match_b)
+	if err2 != nil {
+		return Game{}, fmt.Errorf("failed to parse button B: %w", err2)
+	}
+
+	// // DO NOT TRY THIS AT HOME
+	prize_pos, err3 := parsePositionRegex(match_prize)
+	if err3 != nil {
+		return Game{}, fmt.Errorf("failed to parse prize position: %w", err3)
+	}
+
+	return Game{
+		ButtonA:  button_a,
+		ButtonB:  button_b,
+		PrizePos: prize_pos,
+	}, nil
+}
+
+// Parse reads the claw machines, one paragraph each.
+func Parse(r io.Reader) ([]Game, error) {
+	paragraphs, err := input.Paragraphs(r)
 	if err != nil {
-		return nil, fmt.Errorf("could not open file: %w", err)
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	var games []Game
-	temp := make([]string, 3)
-	i := 0
-
-	for scanner.Scan() {
-		line := scanner.Text()
-		if line != "" {
-			temp[i] = line
-			i++
-			if i < 3 {
-				continue
-			}
-		}
+		return nil, err
+	}
 
-		if i == 3 && line == "" {
-			reButton := regexp.MustCompile(`Button [AB]: X\+(\d+), Y\+(\d+)`)
-			rePrize := regexp.MustCompile(`Prize: X=(\d+), Y=(\d+)`)
-
-			match_a := reButton.FindAllStringSubmatch(temp[0], -1)
-			match_b := reButton.FindAllStringSubmatch(temp[1], -1)
-			match_prize := rePrize.FindAllStringSubmatch(temp[2], -1)
-
-			button_a, err1 := parsePositionRegex(match_a)
-			if err1 != nil {
-				return nil, fmt.Errorf("failed to parse button A: %w", err1)
-			}
-
-			button_b, err2 := parsePositionRegex(match_b)
-			if err2 != nil {
-				return nil, fmt.Errorf("failed to parse button B: %w", err2)
-			}
-
-			// // DO NOT TRY THIS AT HOME
-			prize_pos, err3 := parsePositionRegex(match_prize)
-			if err3 != nil {
-				return nil, fmt.Errorf("failed to parse prize position: %w", err3)
-			}
-
-			if part2 {
-				prize_pos = prize_pos.Add(Position{10000000000000, 10000000000000})
-			}
-			game := Game{
-				ButtonA:  button_a,
-				ButtonB:  button_b,
-				PrizePos: prize_pos,
-			}
-			games = append(games, game)
-			temp = make([]string, 3)
-			i = 0
+	// Every game is a paragraph of button A, button B and prize lines
+	games := make([]Game, len(paragraphs))
+	for i, p := range paragraphs {
+		if len(p.Lines) != 3 {
+			return nil, &input.LineError{Line: p.Line, Err: fmt.Errorf("a game should have 3 lines, but got %d", len(p.Lines))}
 		}
-	}
 
-	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("error while reading file: %w", err)
+		games[i], err = parseGame(p.Lines)
+		if err != nil {
+			return nil, &input.LineError{Line: p.Line, Err: err}
+		}
 	}
 
 	return games, nil
@@ -190,14 +170,14 @@ func linearAlgebraGoBrrrr(game Game) int {
 	// x = inv(A)*b
 	x := [2]float64{float64(b.X)*A_inv[0][0] + float64(b.Y)*A_inv[1][0], float64(b.X)*A_inv[0][1] + float64(b.Y)*A_inv[1][1]}
 
-	// Only count positive solutions
-	if x[0] < 0 || x[1] < 0 {
-		return 0
-	}
-
 	// Only count integer solutions, so check if after rounding, the solutions still holds.
 	sol := [2]int{int(math.Round(x[0])), int(math.Round(x[1]))}
 
+	// Only count positive solutions. After rounding, as no presses can come out as a tiny negative number
+	if sol[0] < 0 || sol[1] < 0 {
+		return 0
+	}
+
 	// Check Solution
 	if !(sol[0]*v_a.X+sol[1]*v_b.X == b.X && sol[0]*v_a.Y+sol[1]*v_b.Y == b.Y) {
 		return 0
@@ -207,40 +187,31 @@ func linearAlgebraGoBrrrr(game Game) int {
 	return sol[0]*CostA + sol[1]*CostB
 }
 
-func part1() {
-	games, err := loadInput("input.txt", false)
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-
+// Part1 sums the fewest tokens needed to win every prize that can be won.
+func Part1(ctx context.Context, games []Game) (string, error) {
 	sum := 0.0
 	for _, game := range games {
-		fmt.Println(game)
+		slog.Debug("playing", "game", game)
 		score := playWrapper(game)
 		if score != math.Inf(1) {
 			sum += score
 		}
 	}
 
-	fmt.Println("Part I: ", sum)
+	return strconv.Itoa(int(sum)), nil
 }
 
-func part2() {
-	games, err := loadInput("input.txt", true)
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-
+// Part2 does the same, with every prize moved by 10000000000000.
+func Part2(ctx context.Context, games []Game) (string, error) {
 	sum := 0
 	for _, game := range games {
+		game.PrizePos = game.PrizePos.Add(Position{X: 10000000000000, Y: 10000000000000})
 		sum += linearAlgebraGoBrrrr(game)
 	}
 
-	fmt.Println("Part II: ", sum)
+	return strconv.Itoa(sum), nil
 }
-func main() {
-	part1()
-	part2()
+
+func init() {
+	solver.Register(13, solver.New(Parse, Part1, Part2))
 }
@@ -0,0 +1,123 @@
+package day13
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/gen"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/difftest"
+)
+
+// No trailing blank line, the last game has to be parsed too
+const example = `Button A: X+94, Y+34
+Button B: X+22, Y+67
+Prize: X=8400, Y=5400
+
+Button A: X+26, Y+66
+Button B: X+67, Y+21
+Prize: X=12748, Y=12176
+
+Button A: X+17, Y+86
+Button B: X+84, Y+37
+Prize: X=7870, Y=6450
+
+Button A: X+69, Y+23
+Button B: X+27, Y+71
+Prize: X=18641, Y=10279`
+
+func TestLoadInput(t *testing.T) {
+	games, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 4 {
+		t.Fatalf("parsed %d games, want 4", len(games))
+	}
+
+	want := Game{ButtonA: Position{X: 69, Y: 23}, ButtonB: Position{X: 27, Y: 71}, PrizePos: Position{X: 18641, Y: 10279}}
+	if games[3] != want {
+		t.Errorf("last game = %v, want %v", games[3], want)
+	}
+
+	if _, err := Parse(strings.NewReader("Button A: X+94, Y+34\nButton B: X+22, Y+67\n")); err == nil {
+		t.Error("expected an error for an incomplete game")
+	}
+}
+
+func TestExample(t *testing.T) {
+	games, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Part1(context.Background(), games)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "480" {
+		t.Errorf("part 1 = %s, want 480", got)
+	}
+}
+
+// The example has no part 2 answer, only the second and fourth machine can be won
+func TestLinearAlgebra(t *testing.T) {
+	games, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, game := range games {
+		// Both solvers agree on the small prizes, the search returns +Inf for the unwinnable games
+		want := 0
+		if cost := playWrapper(game); !math.IsInf(cost, 1) {
+			want = int(cost)
+		}
+		if got := linearAlgebraGoBrrrr(game); got != want {
+			t.Errorf("game %d: linear algebra costs %d, search %d", i+1, got, want)
+		}
+
+		game.PrizePos = game.PrizePos.Add(Position{X: 10000000000000, Y: 10000000000000})
+		winnable := linearAlgebraGoBrrrr(game) != 0
+		if wantWin := i == 1 || i == 3; winnable != wantWin {
+			t.Errorf("game %d with offset: winnable = %v, want %v", i+1, winnable, wantWin)
+		}
+	}
+}
+
+// The search and the linear algebra agree on every machine of a generated input
+func FuzzPlay(f *testing.F) {
+	for seed := range uint64(8) {
+		f.Add(seed, uint8(10))
+	}
+	f.Fuzz(func(t *testing.T, seed uint64, n uint8) {
+		input := gen.ClawMachines(gen.New(seed), int(n%32)+1)
+		difftest.Check(t, input, playAgrees, difftest.Paragraphs, difftest.Numbers)
+	})
+}
+
+func playAgrees(input string) error {
+	games, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return nil
+	}
+
+	for _, game := range games {
+		// Like the puzzle, buttons move forward, and not the same way
+		a, b := game.ButtonA, game.ButtonB
+		if a.X <= 0 || a.Y <= 0 || b.X <= 0 || b.Y <= 0 || a.X*b.Y == a.Y*b.X {
+			return nil
+		}
+
+		want := 0
+		if cost := playWrapper(game); !math.IsInf(cost, 1) {
+			want = int(cost)
+		}
+		if got := linearAlgebraGoBrrrr(game); got != want {
+			return fmt.Errorf("%v: linear algebra costs %d, search %d", game, got, want)
+		}
+	}
+	return nil
+}
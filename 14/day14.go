@@ -0,0 +1,151 @@
+package day14
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Robot type
+type Robot struct {
+	ID   int
+	P, V geom.Vec
+}
+
+func (r Robot) String() string {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "Robot{ID=%d, p=%v, v=%v}", r.ID, r.P, r.V)
+	return builder.String()
+}
+
+// Reading inputs
+func Parse(r io.Reader) ([]Robot, error) {
+	// ex. line: p=74,25 v=-62,4
+	robots, err := input.ParseLines(r, func(line string) (Robot, error) {
+		nums, err := input.Ints(line)
+		if err != nil {
+			return Robot{}, fmt.Errorf("error parsing line %v: %w", line, err)
+		}
+		if len(nums) != 4 {
+			return Robot{}, fmt.Errorf("robot should be in a format 'p=x,y v=x,y', but got: %v", line)
+		}
+		return Robot{P: geom.Vec{X: nums[0], Y: nums[1]}, V: geom.Vec{X: nums[2], Y: nums[3]}}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for id := range robots {
+		robots[id].ID = id
+	}
+	return robots, nil
+}
+
+func moveRobot(r *Robot, mapSize geom.Vec) {
+	pos, vel := r.P, r.V
+	newPos := pos.Add(vel)
+	r.P = newPos.Mod(mapSize)
+}
+
+func findQuadrant(r *Robot, mapSize geom.Vec) int {
+	middle := geom.Vec{X: mapSize.X / 2, Y: mapSize.Y / 2} // Adjust middle point to be center, not one step offset
+
+	pos := r.P
+
+	if pos.X > middle.X && pos.Y < middle.Y {
+		// Top Right
+		return 0
+	}
+	if pos.X > middle.X && pos.Y > middle.Y {
+		// Bottom Right
+		return 1
+	}
+	if pos.X < middle.X && pos.Y > middle.Y {
+		// Bottom Left
+		return 2
+	}
+	if pos.X < middle.X && pos.Y < middle.Y {
+		// Top Left
+		return 3
+	}
+	return -1
+}
+
+// Moves the robots n_steps times, calling onStep (if not nil) after every step
+func simulateRobots(robots []Robot, mapSize geom.Vec, n_steps int, onStep func(step int, robots []Robot)) {
+	for i := 0; i < n_steps; i++ {
+		for id := range robots {
+			moveRobot(&robots[id], mapSize)
+		}
+		if onStep != nil {
+			onStep(i+1, robots)
+		}
+	}
+}
+
+func getSafety(robots []Robot, mapSize geom.Vec) int {
+	quads := make([]int, 4)
+	for id := range robots {
+		q := findQuadrant(&robots[id], mapSize)
+		if q == -1 {
+			continue
+		}
+		quads[q]++
+	}
+
+	return quads[0] * quads[1] * quads[2] * quads[3]
+}
+
+// Animate draws the robots for every step, until their positions repeat.
+// The christmas tree is somewhere in there, use Options.Every to keep the GIF small.
+func Animate(robots []Robot, a render.Sink) error {
+	mapSize := geom.Vec{X: 101, Y: 103}
+	points := make([]grid.Point, len(robots))
+	simulateRobots(robots, mapSize, mapSize.X*mapSize.Y, func(step int, robots []Robot) {
+		for i, r := range robots {
+			points[i] = r.P
+		}
+		frame := render.PointsFrame(mapSize.X, mapSize.Y, points, render.Green)
+		frame.Label = fmt.Sprintf("second %d, safety %d", step, getSafety(robots, mapSize))
+		a.Add(frame)
+	})
+	return nil
+}
+
+// Part1 returns the safety factor after 100 seconds.
+func Part1(ctx context.Context, robots []Robot) (string, error) {
+	mapSize := geom.Vec{X: 101, Y: 103}
+	simulateRobots(robots, mapSize, 100, nil)
+	return strconv.Itoa(getSafety(robots, mapSize)), nil
+}
+
+// Part2 finds the first second the robots form a christmas tree.
+// The robots repeat their positions every 101*103 steps.
+// Robots forming the christmas tree are clustered together, which shows up as the lowest safety score.
+// That's a heuristic: the tree is off centre, piling robots in some quadrants and emptying others.
+// Use Animate, or aoc render -day 14, to see the tree for yourself.
+func Part2(ctx context.Context, robots []Robot) (string, error) {
+	mapSize := geom.Vec{X: 101, Y: 103}
+
+	bestStep, bestSafety := 0, -1
+	for i := 1; i <= 101*103; i++ {
+		simulateRobots(robots, mapSize, 1, nil)
+		safety := getSafety(robots, mapSize)
+		if bestSafety == -1 || safety < bestSafety {
+			bestStep, bestSafety = i, safety
+		}
+	}
+	return strconv.Itoa(bestStep), nil
+}
+
+func init() {
+	solver.Register(14, solver.New(Parse, Part1, Part2))
+}
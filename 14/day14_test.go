@@ -0,0 +1,90 @@
+package day14
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+)
+
+const example = `p=0,4 v=3,-3
+p=6,3 v=-1,-3
+p=10,3 v=-1,2
+p=2,0 v=2,-1
+p=0,0 v=1,3
+p=3,0 v=-2,-2
+p=7,6 v=-1,-3
+p=3,0 v=-1,-2
+p=9,3 v=2,3
+p=7,3 v=-1,2
+p=2,4 v=2,-3
+p=9,5 v=-3,-3
+`
+
+func TestReadInput(t *testing.T) {
+	robots, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(robots) != 12 {
+		t.Fatalf("parsed %d robots, want 12", len(robots))
+	}
+	if robots[0].P != (geom.Vec{X: 0, Y: 4}) || robots[0].V != (geom.Vec{X: 3, Y: -3}) {
+		t.Errorf("first robot = %v", robots[0])
+	}
+}
+
+// The example is played on a smaller 11x7 space
+func TestSafety(t *testing.T) {
+	robots, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mapSize := geom.Vec{X: 11, Y: 7}
+	simulateRobots(robots, mapSize, 100, nil)
+	if got := getSafety(robots, mapSize); got != 12 {
+		t.Errorf("safety after 100 seconds = %d, want 12", got)
+	}
+}
+
+func TestMoveRobot(t *testing.T) {
+	r := Robot{P: geom.Vec{X: 2, Y: 4}, V: geom.Vec{X: 2, Y: -3}}
+	mapSize := geom.Vec{X: 11, Y: 7}
+
+	// Wraps around the edges
+	for _, want := range []geom.Vec{{X: 4, Y: 1}, {X: 6, Y: 5}, {X: 8, Y: 2}, {X: 10, Y: 6}, {X: 1, Y: 3}} {
+		moveRobot(&r, mapSize)
+		if r.P != want {
+			t.Fatalf("robot at %v, want %v", r.P, want)
+		}
+	}
+}
+
+// Most robots gather in the top left quadrant for one second, like the tree, the others are scattered everywhere.
+// Without the scattered ones, the robots would line up in x every 101 seconds too.
+func TestPart2FindsCluster(t *testing.T) {
+	const second = 1234
+	mapSize := geom.Vec{X: 101, Y: 103}
+	r := rand.New(rand.NewPCG(14, 14))
+
+	robots := make([]Robot, 500)
+	for i := range robots {
+		v := geom.Vec{X: r.IntN(201) - 100, Y: r.IntN(201) - 100}
+		at := geom.Vec{X: r.IntN(mapSize.X), Y: r.IntN(mapSize.Y)}
+		if i < 300 {
+			at = geom.Vec{X: 10 + r.IntN(20), Y: 10 + r.IntN(20)}
+		}
+		robots[i] = Robot{ID: i, P: at.Sub(v.Scale(second)).Mod(mapSize), V: v}
+	}
+
+	got, err := Part2(context.Background(), robots)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "1234" {
+		t.Errorf("Part2() = %s, want %d", got, second)
+	}
+}
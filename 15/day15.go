@@ -0,0 +1,132 @@
+package day15
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	wh1 "github.com/SpicyHolo/advent_of_code_2024/15/warehouse1"
+	wh2 "github.com/SpicyHolo/advent_of_code_2024/15/warehouse2"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Parse reads the warehouse map, and the moves of the robot.
+func Parse(r io.Reader) (*wh1.State, error) {
+	paragraphs, err := input.Paragraphs(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(paragraphs) != 2 {
+		return nil, fmt.Errorf("expected the warehouse map and the commands separated by an empty line")
+	}
+	warehouse := paragraphs[0].Lines
+	commands := strings.Join(paragraphs[1].Lines, "")
+
+	// Convert warehouse to [][]byte
+	warehouse_bytes := make([][]byte, len(warehouse))
+	for i, s := range warehouse {
+		warehouse_bytes[i] = []byte(s)
+	}
+
+	state, err := wh1.NewState(warehouse_bytes, commands)
+	if err != nil {
+		return nil, fmt.Errorf("error loading state: %w", err)
+	}
+
+	return state, nil
+}
+
+func parseInput2(state *wh1.State) (*wh2.State, error) {
+	warehouse, commands := state.Map, state.Commands
+
+	newMap := make([][]byte, len(warehouse))
+
+	for i, row := range warehouse {
+		temp := string(row)
+		temp = strings.ReplaceAll(temp, "#", "##")
+		temp = strings.ReplaceAll(temp, "O", "[]")
+		temp = strings.ReplaceAll(temp, ".", "..")
+		temp = strings.ReplaceAll(temp, "@", "@.")
+		newMap[i] = []byte(temp)
+	}
+
+	new_state, err := wh2.NewState(newMap, commands)
+	if err != nil {
+		return nil, err
+	}
+	return new_state, nil
+}
+
+// Part1 sums the GPS coordinates of the boxes after all moves.
+func Part1(ctx context.Context, state *wh1.State) (string, error) {
+	for state.NextCommand() {
+	}
+	return strconv.Itoa(state.Score()), nil
+}
+
+// Part2 does the same in a twice as wide warehouse.
+func Part2(ctx context.Context, state *wh1.State) (string, error) {
+	newState, err := parseInput2(state)
+	if err != nil {
+		return "", err
+	}
+
+	for newState.NextCommand() {
+	}
+
+	return strconv.Itoa(newState.Score()), nil
+}
+
+// Draws the warehouse map, with walls, boxes and the robot in their own colours
+func warehouseFrame(warehouse [][]byte, move, moves int) *render.Frame {
+	frame := render.NewFrame(len(warehouse[0]), len(warehouse))
+	for y, row := range warehouse {
+		for x, char := range row {
+			var c uint8
+			switch char {
+			case '#':
+				c = render.White
+			case 'O', '[', ']':
+				c = render.Yellow
+			case '@':
+				c = render.Green
+			default:
+				continue
+			}
+			frame.Set(grid.Point{X: x, Y: y}, c)
+		}
+	}
+	frame.Label = fmt.Sprintf("move %d/%d", move, moves)
+	return frame
+}
+
+// Animate draws the warehouse after every move of the robot, in the twice as wide warehouse of part II if wide is set.
+func Animate(state *wh1.State, a render.Sink, wide bool) error {
+	moves := len(state.Commands)
+	if !wide {
+		a.Add(warehouseFrame(state.Map, 0, moves))
+		state.OnMove = func(s *wh1.State) { a.Add(warehouseFrame(s.Map, s.C_ptr, moves)) }
+		for state.NextCommand() {
+		}
+		return nil
+	}
+
+	newState, err := parseInput2(state)
+	if err != nil {
+		return err
+	}
+	a.Add(warehouseFrame(newState.Map, 0, moves))
+	newState.OnMove = func(s *wh2.State) { a.Add(warehouseFrame(s.Map, s.C_ptr, moves)) }
+	for newState.NextCommand() {
+	}
+	return nil
+}
+
+func init() {
+	solver.Register(15, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,70 @@
+package day15
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	wh1 "github.com/SpicyHolo/advent_of_code_2024/15/warehouse1"
+)
+
+const largerExample = `##########
+#..O..O.O#
+#......O.#
+#.OO..O.O#
+#..O@..O.#
+#O#..O...#
+#O..O..O.#
+#.OO.O.OO#
+#....O...#
+##########
+
+<vv>^<v^>v>^vv^v>v<>v^v<v<^vv<<<^><<><>>v<vvv<>^v^>^<<<><<v<<<v^vv^v>^
+vvv<<^>^v^^><<>>><>^<<><^vv^^<>vvv<>><^^v>^>vv<>v<<<<v<^v>^<^^>>>^<v<v
+><>vv>v^v^<>><>>>><^^>vv>v<^^^>>v^v^<^^>v^^>v^<^v>v<>>v^v^<v>v^^<^^vv<
+<<v<^>>^^^^>>>v^<>vvv^><v<<<>^^^vv^<vvv>^>v<^^^^v<>^>vvvv><>>v^<<^^^^^
+^><^><>>><>^^<<^^v>>><^<v>^<vv>>v>>>^v><>^v><<<<v>>v<v<v>vvv>^<><<>^><
+^>><>^v<><^vvv<^^<><v<<<<<><^v<<<><<<^^<v<^^^><^>>^<v^><<<^>>^v<v^v<v^
+>^>>^v>vv>^<<^v<>><<><<v<<v><>v<^vv<<<>^^v^>^^>>><<^v>>v^v><^^>>^<>vv^
+<><^^>^^^<><vvvvv^v<v<<>^v<v>v<<^><<><<><<<^^<<<^<<>><<><^^^>^^<>^>v<>
+^^>vv<^v^v<vv>^<><v<^v>^^^>>>^^vvv^>vvv<>>>^<^>>>>>^<<^v>^vvv<>^<><<v>
+v^^>>><<^^<>>^v^<v^vv<>v^<<>^<^v^v><^<<<><<^<v><v<>vv>>v><v^<vv<>v^<<^
+`
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, *wh1.State) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "10092"},
+		{"part 2", Part2, "9021"},
+	}
+
+	for _, tt := range tests {
+		for _, newline := range []string{"\n", "\r\n"} {
+			t.Run(fmt.Sprintf("%s %q", tt.name, newline), func(t *testing.T) {
+				input := strings.ReplaceAll(largerExample, "\n", newline)
+				state, err := Parse(strings.NewReader(input))
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				got, err := tt.part(context.Background(), state)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != tt.want {
+					t.Errorf("got %s, want %s", got, tt.want)
+				}
+			})
+		}
+	}
+}
+
+func TestParseInput(t *testing.T) {
+	if _, err := Parse(strings.NewReader("#####\n#@..#\n#####\n")); err == nil {
+		t.Error("expected an error for input without commands")
+	}
+}
@@ -1,16 +1,21 @@
 package warehouse1
 
 import (
-	. "day15/utils"
 	"fmt"
 	"strings"
+
+	. "github.com/SpicyHolo/advent_of_code_2024/15/utils"
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
 )
 
 type State struct {
 	Map      [][]byte
-	Pos      Vec2D
+	Pos      geom.Vec
 	Commands string
 	C_ptr    int
+
+	// Called after every command, e.g. to draw the warehouse
+	OnMove func(s *State)
 }
 
 func (s State) String() string {
@@ -40,22 +45,22 @@ func NewState(Map [][]byte, commands string) (*State, error) {
 				continue
 			}
 
-			s.Pos = *NewVec2D(x, y)
+			s.Pos = geom.Vec{X: x, Y: y}
 			return s, nil
 		}
 	}
 	return nil, fmt.Errorf("no '@' representing robot, found in the map")
 }
 
-// func (s *State) isWall(pos Vec2D) bool {
+// func (s *State) isWall(pos geom.Vec) bool {
 // 	return ArrayGet(pos, s.Map) == '#'
 // }
 
-func (s *State) isBox(pos Vec2D) bool {
+func (s *State) isBox(pos geom.Vec) bool {
 	return ArrayGet(pos, s.Map) == 'O'
 }
 
-func (s *State) isFree(pos Vec2D) bool {
+func (s *State) isFree(pos geom.Vec) bool {
 	return ArrayGet(pos, s.Map) == '.'
 }
 
@@ -67,17 +72,20 @@ func (s *State) NextCommand() bool {
 	// Fetch command (direction)
 	command := s.Commands[s.C_ptr]
 	s.C_ptr++
-	dir := COMMANDS[command]
-
-	s.move(dir)
+	if dir, ok := geom.ParseDir(command); ok {
+		s.move(dir.Vec())
+	}
+	if s.OnMove != nil {
+		s.OnMove(s)
+	}
 	return true
 }
 
-func (s *State) updateMap(pos Vec2D, char byte) {
+func (s *State) updateMap(pos geom.Vec, char byte) {
 	ArraySet(pos, s.Map, char)
 }
 
-func (s *State) moveBox(pos, dir Vec2D) {
+func (s *State) moveBox(pos, dir geom.Vec) {
 	initial_box := pos.Add(dir)
 	cur_pos := initial_box
 
@@ -96,7 +104,7 @@ func (s *State) moveBox(pos, dir Vec2D) {
 	}
 }
 
-func (s *State) move(dir Vec2D) {
+func (s *State) move(dir geom.Vec) {
 	newPos := s.Pos.Add(dir)
 
 	switch {
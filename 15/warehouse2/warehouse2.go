@@ -1,16 +1,21 @@
-package warehouse1
+package warehouse2
 
 import (
-	"day15/utils"
 	"fmt"
 	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/15/utils"
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
 )
 
 type State struct {
 	Map      [][]byte
-	Pos      utils.Vec2D
+	Pos      geom.Vec
 	Commands string
 	C_ptr    int
+
+	// Called after every command, e.g. to draw the warehouse
+	OnMove func(s *State)
 }
 
 func (s State) String() string {
@@ -40,7 +45,7 @@ func NewState(Map [][]byte, commands string) (*State, error) {
 		for x, char := range row {
 			switch char {
 			case '@':
-				s.Pos = utils.Vec2D{X: x, Y: y}
+				s.Pos = geom.Vec{X: x, Y: y}
 			}
 		}
 	}
@@ -63,25 +68,25 @@ func deepCopy(original [][]byte) [][]byte {
 }
 
 // Checkers
-func (s *State) isBox(pos utils.Vec2D) bool {
+func (s *State) isBox(pos geom.Vec) bool {
 	char := s.getValue(pos)
 	return char == '[' || char == ']'
 }
 
-func (s *State) isFree(pos utils.Vec2D) bool {
+func (s *State) isFree(pos geom.Vec) bool {
 	return s.getValue(pos) == '.'
 }
 
-func (s *State) getValue(pos utils.Vec2D) byte {
+func (s *State) getValue(pos geom.Vec) byte {
 	return s.Map[pos.Y][pos.X]
 }
 
-func (s *State) setValue(pos utils.Vec2D, char byte) {
+func (s *State) setValue(pos geom.Vec, char byte) {
 	s.Map[pos.Y][pos.X] = char
 }
 
 // Update's robot position (also visually)
-func (s *State) updateRobot(dir utils.Vec2D) {
+func (s *State) updateRobot(dir geom.Vec) {
 	newPos := s.Pos.Add(dir)
 	s.setValue(s.Pos, '.')
 	s.setValue(newPos, '@')
@@ -97,9 +102,9 @@ For each we add the stuff behind it in movement's direction
 After that we move everything that's in the created array.const
 Works for both horizontal and vertical movement <3
 */
-func (s *State) updateEverything(dir utils.Vec2D) bool {
-	toUpdate := []utils.Vec2D{s.Pos}
-	visited := make(utils.Set[utils.Vec2D]) // Keep a set to only visit each location once, faster than searching through toUpdate array
+func (s *State) updateEverything(dir geom.Vec) bool {
+	toUpdate := []geom.Vec{s.Pos}
+	visited := make(utils.Set[geom.Vec]) // Keep a set to only visit each location once, faster than searching through toUpdate array
 	i := 0
 
 	// Go over the FIFO queue
@@ -118,13 +123,13 @@ func (s *State) updateEverything(dir utils.Vec2D) bool {
 
 			// Add box's second part
 			if s.getValue(new_pos) == '[' {
-				if right := new_pos.Add(utils.DIRECTIONS[utils.RIGHT]); !visited.Contains(right) {
+				if right := new_pos.Add(geom.Right.Vec()); !visited.Contains(right) {
 					toUpdate = append(toUpdate, right)
 					visited.Add(right)
 				}
 			}
 			if s.getValue(new_pos) == ']' {
-				if left := new_pos.Add(utils.DIRECTIONS[utils.LEFT]); !visited.Contains(left) {
+				if left := new_pos.Add(geom.Left.Vec()); !visited.Contains(left) {
 					toUpdate = append(toUpdate, left)
 					visited.Add(left)
 				}
@@ -160,7 +165,7 @@ func (s *State) updateEverything(dir utils.Vec2D) bool {
 }
 
 // Moves the robot, and boxes
-func (s *State) move(dir utils.Vec2D) {
+func (s *State) move(dir geom.Vec) {
 	newPos := s.Pos.Add(dir)
 	switch {
 
@@ -185,9 +190,12 @@ func (s *State) NextCommand() bool {
 	// Fetch command (direction)
 	command := s.Commands[s.C_ptr]
 	s.C_ptr++
-	dir := utils.COMMANDS[command]
-
-	s.move(dir)
+	if dir, ok := geom.ParseDir(command); ok {
+		s.move(dir.Vec())
+	}
+	if s.OnMove != nil {
+		s.OnMove(s)
+	}
 	return true
 }
 
@@ -0,0 +1,195 @@
+package day16
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+	"github.com/SpicyHolo/advent_of_code_2024/search"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Labirynth structure
+type Labirynth struct {
+	Map        *grid.Grid[byte]
+	Start, End Vector
+}
+
+func (lab Labirynth) String() string {
+	return lab.Map.String()
+}
+
+func isFree(lab Labirynth, pos Vector) bool {
+	return lab.Map.At(pos) == '.'
+}
+
+// Position vector
+type Vector = geom.Vec
+
+// State represents an entity's position and direction
+type State struct {
+	Pos Vector
+	Dir geom.Dir
+}
+
+// Set implementation
+type Set[T comparable] map[T]struct{}
+
+func (s Set[T]) Add(element T) {
+	s[element] = struct{}{}
+}
+
+func (s Set[T]) Contains(element T) bool {
+	_, exists := s[element]
+	return exists
+}
+
+// Reads input from a reader
+func Parse(r io.Reader) (Labirynth, error) {
+	Map, err := input.Grid(r)
+	if err != nil {
+		return Labirynth{}, fmt.Errorf("could not read input: %w", err)
+	}
+
+	// Find starting and end position
+	start, foundStart := Map.Find('S')
+	end, foundEnd := Map.Find('E')
+	if !foundStart || !foundEnd {
+		return Labirynth{}, fmt.Errorf("labirynth should contain a start 'S' and an end 'E'")
+	}
+	Map.Set(start, '.')
+	Map.Set(end, '.')
+
+	// Create labirynth object
+	l := Labirynth{
+		Map:   Map,
+		Start: start,
+		End:   end,
+	}
+
+	return l, nil
+}
+
+// Costs of the moves of the reindeer
+const (
+	moveCost = 1
+	turnCost = 1000
+)
+
+// Moves forward, or turns a quarter either way, if the reindeer ends up on a free tile
+func getAdjacent(lab Labirynth, state State) []search.Edge[State] {
+	pos, dir := state.Pos, state.Dir
+	moves := []search.Edge[State]{
+		{To: State{pos.Add(dir.Vec()), dir}, Cost: moveCost},
+		{To: State{pos, dir.CW()}, Cost: turnCost},
+		{To: State{pos, dir.CCW()}, Cost: turnCost},
+	}
+
+	var adj []search.Edge[State]
+	for _, move := range moves {
+		if isFree(lab, move.To.Pos) {
+			adj = append(adj, move)
+		}
+	}
+	return adj
+}
+
+// Every best path from the start, facing east, to the end facing any way
+func bestPaths(lab Labirynth) *search.DAG[State] {
+	return search.AllShortestPathsDAG(
+		State{lab.Start, geom.Right},
+		func(s State) []search.Edge[State] { return getAdjacent(lab, s) },
+		func(s State) bool { return s.Pos == lab.End },
+	)
+}
+
+// Dijkstra returns the lowest score from the start to the end, and every path with that score, or -1 if there's none.
+func Dijkstra(lab Labirynth) (int, [][]State) {
+	paths := bestPaths(lab)
+	if len(paths.Ends) == 0 {
+		return -1, nil
+	}
+
+	var allPaths [][]State
+	for _, end := range paths.Ends {
+		allPaths = append(allPaths, paths.Paths(end)...)
+	}
+	return paths.Dist[paths.Ends[0]], allPaths
+}
+
+var errNoPath = errors.New("no path found")
+
+// Marks the states on the best paths on the map with 'x', returns the number of unique tiles (seats) on them
+func markPaths(lab Labirynth, states map[State]bool) int {
+	seats := 0
+	for state := range states {
+		if lab.Map.At(state.Pos) != 'x' {
+			seats++
+		}
+		lab.Map.Set(state.Pos, 'x')
+	}
+	return seats
+}
+
+// Part1 returns the lowest score from the start to the end.
+func Part1(ctx context.Context, labirynth Labirynth) (string, error) {
+	cost, _ := Dijkstra(labirynth)
+	if cost == -1 {
+		return "", errNoPath
+	}
+	return strconv.Itoa(cost), nil
+}
+
+// Part2 counts the tiles that are part of any best path.
+func Part2(ctx context.Context, labirynth Labirynth) (string, error) {
+	paths := bestPaths(labirynth)
+	if len(paths.Ends) == 0 {
+		return "", errNoPath
+	}
+
+	// Update the map with the paths found
+	seats := markPaths(labirynth, paths.On(paths.Ends...))
+	return strconv.Itoa(seats), nil
+}
+
+// Animate traces the best paths through the labirynth one tile at a time, keeping the ones already traced.
+func Animate(lab Labirynth, a render.Sink) error {
+	cost, allPaths := Dijkstra(lab)
+	if cost == -1 {
+		return errNoPath
+	}
+
+	traced := make(Set[Vector])
+	for i, path := range allPaths {
+		for j := range path {
+			frame := render.GridFrame(lab.Map, func(p grid.Point, char byte) uint8 {
+				switch {
+				case char == '#':
+					return render.White
+				case traced.Contains(p):
+					return render.Yellow
+				}
+				return render.Black
+			})
+			for _, prev := range path[:j+1] {
+				frame.Set(prev.Pos, render.Green)
+			}
+			frame.Label = fmt.Sprintf("path %d/%d, cost %d", i+1, len(allPaths), cost)
+			a.Add(frame)
+		}
+		for _, state := range path {
+			traced.Add(state.Pos)
+		}
+	}
+	return nil
+}
+
+func init() {
+	solver.Register(16, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,91 @@
+package day16
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/internal/benchtest"
+)
+
+const example = `###############
+#.......#....E#
+#.#.###.#.###.#
+#.....#.#...#.#
+#.###.#####.#.#
+#.#.#.......#.#
+#.#.#####.###.#
+#...........#.#
+###.#.#####.#.#
+#...#.....#.#.#
+#.#.#.###.#.#.#
+#.....#...#.#.#
+#.###.#.#.#.#.#
+#S..#.....#...#
+###############
+`
+
+const example2 = `#################
+#...#...#...#..E#
+#.#.#.#.#.#.#.#.#
+#.#.#.#...#...#.#
+#.#.#.#.###.#.#.#
+#...#.#.#.....#.#
+#.#.#.#.#.#####.#
+#.#...#.#.#.....#
+#.#.#####.#.###.#
+#.#.#.......#...#
+#.#.###.#####.###
+#.#.#...#.....#.#
+#.#.#.#####.###.#
+#.#.#.........#.#
+#.#.#.#########.#
+#S#.............#
+#################
+`
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		part  func(context.Context, Labirynth) (string, error)
+		want  string
+	}{
+		{"part 1", example, Part1, "7036"},
+		{"part 2", example, Part2, "45"},
+		{"part 1, second example", example2, Part1, "11048"},
+		{"part 2, second example", example2, Part2, "64"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lab, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), lab)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNoPath(t *testing.T) {
+	lab, err := Parse(strings.NewReader("#####\n#S#E#\n#####\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Part1(context.Background(), lab); err == nil {
+		t.Error("expected an error for a maze without a path")
+	}
+}
+
+// A 141x141 reindeer maze, as generated by aoc gen, searched with either priority queue
+func BenchmarkParts(b *testing.B) {
+	benchtest.Queues(b, 16)
+}
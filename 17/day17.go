@@ -1,12 +1,18 @@
-package main
+package day17
 
 import (
+	"context"
 	"fmt"
-	"os"
+	"io"
+	"log/slog"
+	"maps"
 	"regexp"
 	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
 )
 
 type Computer struct {
@@ -40,22 +46,22 @@ var OPCODE2OPERATION = map[int]string{
 	7: "cdv",
 }
 
-func parseInput(filename string) (map[string]int, []int, error) {
-
-	// Read file
-	buf, err := os.ReadFile(filename)
+func parseInput(r io.Reader) (map[string]int, []int, error) {
+	// Read input
+	data, err := input.Text(r)
 	if err != nil {
-		return nil, nil, fmt.Errorf("could not read file: %w", err)
-
+		return nil, nil, err
 	}
 
-	// Parse file
+	// Parse input
 	regex_reg := regexp.MustCompile(`Register [A-C]: (\d+)`)
-	regex_program := regexp.MustCompile(`Program: ((?:\d,|\d$)+)`)
+	regex_program := regexp.MustCompile(`Program: (\d(?:,\d)*)`)
 
-	data := string(buf)
 	matches_reg := regex_reg.FindAllStringSubmatch(data, -1)
 	matches_program := regex_program.FindAllStringSubmatch(data, -1)
+	if len(matches_reg) != 3 || len(matches_program) != 1 {
+		return nil, nil, fmt.Errorf("input should contain registers A, B, C and a program")
+	}
 
 	a, err1 := strconv.Atoi(matches_reg[0][1])
 	if err1 != nil {
@@ -76,23 +82,9 @@ func parseInput(filename string) (map[string]int, []int, error) {
 	registers["B"] = b
 	registers["C"] = c
 
-	program_nums := strings.Split(matches_program[0][1], ",")
-<<<<<<< HEAD
-	program := make([]int, len(program_nums) - 1)
-=======
-
-	// Convert an array of strings to integers
-	program := make([]int, len(program_nums))
->>>>>>> a7ef85a (day 17 changes)
-	for i, num := range program_nums {
-    if i == len(program_nums) - 1 {
-      break
-    }
-		numInt, err := strconv.Atoi(num)
-		if err != nil {
-			return nil, nil, fmt.Errorf("could not convert to int: %w", err)
-		}
-		program[i] = numInt
+	program, err := input.Ints(matches_program[0][1])
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not convert to int: %w", err)
 	}
 
 	return registers, program, nil
@@ -163,33 +155,11 @@ func outputToStr(output []int) string {
 	return strings.Join(outputStr, ",")
 }
 
-func part1(filename string) {
-	fmt.Println("Part I")
-
-	// Parse Input
-	reg, program, err := parseInput(filename)
-	if err != nil {
-		fmt.Println(err)
-		return
-	}
-
-	// Initialise
-	computer := Computer{reg, program, 0}
+// Executes the program until it halts, returns its output
+func (c *Computer) run() []int {
 	var output []int
-
-	// Modify register A
-	if len(os.Args) > 2 {
-		newA, err := strconv.Atoi(os.Args[2])
-		if err != nil {
-			fmt.Println("error converting arg 2 to string: %w", err)
-		}
-		computer.Reg["A"] = newA
-	}
-
-	// Execute program
 	for {
-		// fmt.Println(computer)
-		res := computer.nextCommand()
+		res := c.nextCommand()
 		// No commands left
 		if res == -2 {
 			break
@@ -200,66 +170,56 @@ func part1(filename string) {
 			output = append(output, res)
 		}
 	}
+	return output
+}
 
-	if slices.Equal(output, computer.Prog) {
-		fmt.Println("output == program")
-	}
-
-	fmt.Println("Output: ", outputToStr(output))
+// Input registers and program
+type Device struct {
+	Registers map[string]int
+	Program   []int
 }
 
-func part2(filename string) int {
-	// Parse Input
-	reg, program, err := parseInput(filename)
-  A := 0
-  B := reg["B"]
-  C := reg["C"]
+// Parse reads the registers, and the program.
+func Parse(r io.Reader) (Device, error) {
+	reg, program, err := parseInput(r)
 	if err != nil {
-	 	fmt.Println(err)
-	  return 0
+		return Device{}, err
 	}
-	for i, code := range program {
-		fmt.Println("i: ", i)
-    B = B % 8
-		B = B ^ 1
-		c_shift := B
-
-		B = B ^ 5
-		C = B ^ code
-    B = B ^ C
-		temp_a := C << c_shift
-    A += temp_a
-    fmt.Println(A)
-    A = A << 3
+	return Device{reg, program}, nil
+}
 
-	}
-  sol := solver(12, 0, 0) 
-  fmt.Println(program, sol)
-	return 12
+// Part1 returns the output of the program.
+func Part1(ctx context.Context, d Device) (string, error) {
+	computer := Computer{d.Registers, d.Program, 0}
+	return outputToStr(computer.run()), nil
 }
 
-func solver(A, B, C int) (sol []int) {
-	// Parse Input
-	// reg, program, err := parseInput("input.txt")
-	// if err != nil {
-	// 	fmt.Println(err)
-	// 	return
-	// }
+// The program shifts A right by 3 bits every loop, and outputs once per loop.
+// So A is built 3 bits at a time, matching the output from the back of the program.
+func Part2(ctx context.Context, d Device) (string, error) {
+	A := find(d, 1, 0)
+	if A == -1 {
+		return "", fmt.Errorf("no value of register A outputs the program")
+	}
+	return strconv.Itoa(A), nil
+}
 
-func find(program []int, ans int) int {
-	if len(program) == 0 {
+// Finds the lowest A (starting with the bits in ans), for which the output matches the last n program values
+func find(d Device, n int, ans int) int {
+	if n > len(d.Program) {
 		return ans
 	}
-	a, b, c := 0, 0, 0
+
 	for t := 0; t < 8; t++ {
-		a = ans<<3 | t
-		b = a & (8 - 1)
-		b ^= 1
-		c = a >> b
-		b ^= 5
-		b ^= c
-		if b&(8-1) == program[len(program)-1] {
-			sub := find(program[:len(program)-1], a)
+		a := ans<<3 | t
+
+		reg := maps.Clone(d.Registers)
+		reg["A"] = a
+		computer := Computer{reg, d.Program, 0}
+
+		if slices.Equal(computer.run(), d.Program[len(d.Program)-n:]) {
+			slog.Debug("matched the end of the program", "A", a, "values", n)
+			sub := find(d, n+1, a)
 			if sub == -1 {
 				continue
 			}
@@ -269,10 +229,6 @@ func find(program []int, ans int) int {
 	return -1
 }
 
-func main() {
-	if len(os.Args) > 1 {
-		part2(os.Args[1])
-		return
-	}
-	fmt.Println("not enough arguments, expected filename.")
+func init() {
+	solver.Register(17, solver.New(Parse, Part1, Part2))
 }
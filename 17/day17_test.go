@@ -0,0 +1,79 @@
+package day17
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestPart1(t *testing.T) {
+	const example = `Register A: 729
+Register B: 0
+Register C: 0
+
+Program: 0,1,5,4,3,0
+`
+	d, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Part1(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "4,6,3,5,6,3,5,2,1,0"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
+
+func TestPart2(t *testing.T) {
+	const example = `Register A: 2024
+Register B: 0
+Register C: 0
+
+Program: 0,3,5,4,3,0
+`
+	d, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := Part2(context.Background(), d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != "117440" {
+		t.Errorf("got %s, want 117440", got)
+	}
+}
+
+// Small programs from the puzzle description, checking single instructions
+func TestInstructions(t *testing.T) {
+	tests := []struct {
+		name    string
+		reg     map[string]int
+		program []int
+		output  string
+		check   string
+		want    int
+	}{
+		{"bst", map[string]int{"A": 0, "B": 0, "C": 9}, []int{2, 6}, "", "B", 1},
+		{"out", map[string]int{"A": 10, "B": 0, "C": 0}, []int{5, 0, 5, 1, 5, 4}, "0,1,2", "", 0},
+		{"adv loop", map[string]int{"A": 2024, "B": 0, "C": 0}, []int{0, 1, 5, 4, 3, 0}, "4,2,5,6,7,7,7,7,3,1,0", "A", 0},
+		{"bxl", map[string]int{"A": 0, "B": 29, "C": 0}, []int{1, 7}, "", "B", 26},
+		{"bxc", map[string]int{"A": 0, "B": 2024, "C": 43690}, []int{4, 0}, "", "B", 44354},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			computer := Computer{tt.reg, tt.program, 0}
+			if got := outputToStr(computer.run()); got != tt.output {
+				t.Errorf("output = %q, want %q", got, tt.output)
+			}
+			if tt.check != "" && tt.reg[tt.check] != tt.want {
+				t.Errorf("register %s = %d, want %d", tt.check, tt.reg[tt.check], tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package day18
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+	"github.com/SpicyHolo/advent_of_code_2024/search"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+type Vec = geom.Vec
+
+// Parses input for all corrupted memory
+func Parse(r io.Reader) ([]Vec, error) {
+	return input.ParseLines(r, func(line string) (Vec, error) {
+		digits := strings.Split(line, ",")
+		if len(digits) != 2 {
+			return Vec{}, fmt.Errorf("invalid number of arguments, expected 'x,y', but got: %v", line)
+		}
+
+		// Convert each digit to int
+		x, err1 := strconv.Atoi(digits[0])
+		if err1 != nil {
+			return Vec{}, fmt.Errorf("could not convert %v to int: %w", digits[0], err1)
+		}
+		y, err2 := strconv.Atoi(digits[1])
+		if err2 != nil {
+			return Vec{}, fmt.Errorf("could not convert %v to int: %w", digits[1], err2)
+		}
+
+		return Vec{X: x, Y: y}, nil
+	})
+}
+
+// Get adjacent nodes, checking for bounds and if are not corrupted
+func getAdj(curPos Vec, corrupted map[Vec]struct{}, mapSize Vec) []Vec {
+	var adjacent []Vec
+	for _, dir := range geom.Dirs {
+		newPos := curPos.Add(dir.Vec())
+
+		// Check if new position is in bounds
+		if newPos.X < 0 || newPos.Y < 0 || newPos.X >= mapSize.X || newPos.Y >= mapSize.Y {
+			continue
+		}
+
+		// Check if new position is not corrupted
+		if _, contains := corrupted[newPos]; contains {
+			continue
+		}
+
+		adjacent = append(adjacent, newPos)
+	}
+
+	return adjacent
+}
+
+// Search for the shortest path in a graph, with the help of a heuristic
+func AStar(start, end Vec, occupied map[Vec]struct{}, mapSize Vec) ([]Vec, int) {
+	res := search.AStar(start,
+		func(curPos Vec) []search.Edge[Vec] { return search.Unit(getAdj(curPos, occupied, mapSize)...) },
+		func(curPos Vec) bool { return curPos == end },
+		func(curPos Vec) int { return geom.Manhattan(curPos, end) }, // never more than the steps left
+	)
+
+	// No path found
+	if !res.Found {
+		return nil, -1
+	}
+	return res.Path(end), res.Dist[end]
+}
+
+// Finds the shortest path after the first n bytes have fallen
+func shortestPath(data []Vec, n int, mapSize Vec) int {
+	if n > len(data) {
+		n = len(data)
+	}
+
+	// Create set of n first corrupted memories
+	corrupted := make(map[Vec]struct{})
+	for _, v := range data[:n] {
+		corrupted[v] = struct{}{}
+	}
+
+	end := Vec{X: mapSize.X - 1, Y: mapSize.Y - 1}
+	_, length := AStar(Vec{X: 0, Y: 0}, end, corrupted, mapSize)
+	return length
+}
+
+// Finds the first byte, that cuts off the exit (the first n bytes are known to be safe)
+// onFall (if not nil) is called after every byte falls, with the current path to the exit
+func firstBlocking(data []Vec, n int, mapSize Vec, onFall func(corrupted map[Vec]struct{}, path []Vec)) (Vec, bool) {
+	if n > len(data) {
+		n = len(data)
+	}
+	start, end := Vec{X: 0, Y: 0}, Vec{X: mapSize.X - 1, Y: mapSize.Y - 1}
+
+	// Create set of n first
+	corrupted := make(map[Vec]struct{})
+
+	for _, v := range data[:n] {
+		corrupted[v] = struct{}{}
+	}
+
+	// Initialise path, create a set for all positions in the path
+	path, _ := AStar(start, end, corrupted, mapSize)
+	pathSet := make(map[Vec]struct{})
+	for _, pos := range path {
+		pathSet[pos] = struct{}{}
+	}
+
+	for i := n; i < len(data); i++ { // First n are already safe, we checked it.
+		corrupted[data[i]] = struct{}{}
+
+		// If new corrupted memory is not in path, continue
+		if _, exists := pathSet[data[i]]; !exists {
+			if onFall != nil {
+				onFall(corrupted, path)
+			}
+			continue
+		}
+
+		// Recalculate path
+		path, _ = AStar(start, end, corrupted, mapSize)
+		if onFall != nil {
+			onFall(corrupted, path)
+		}
+
+		if path == nil {
+			return data[i], true
+		}
+
+		// Recalculate in path set
+		pathSet = make(map[Vec]struct{})
+		for _, pos := range path {
+			pathSet[pos] = struct{}{}
+		}
+
+	}
+	return Vec{}, false
+}
+
+// Part1 returns the fewest steps to the exit, after the first 1024 bytes have fallen.
+func Part1(ctx context.Context, data []Vec) (string, error) {
+	length := shortestPath(data, 1024, Vec{X: 71, Y: 71})
+	if length == -1 {
+		return "", fmt.Errorf("no path found")
+	}
+	return strconv.Itoa(length), nil
+}
+
+// Part2 returns the first byte that cuts off the exit.
+func Part2(ctx context.Context, data []Vec) (string, error) {
+	blocking, found := firstBlocking(data, 1024, Vec{X: 71, Y: 71}, nil)
+	if !found {
+		return "", fmt.Errorf("the exit is never cut off")
+	}
+	return fmt.Sprintf("%d,%d", blocking.X, blocking.Y), nil
+}
+
+// Animate draws the bytes falling, and the shortest path to the exit until it's cut off.
+func Animate(data []Vec, a render.Sink) error {
+	mapSize := Vec{X: 71, Y: 71}
+	firstBlocking(data, 0, mapSize, func(corrupted map[Vec]struct{}, path []Vec) {
+		frame := render.NewFrame(mapSize.X, mapSize.Y)
+		for v := range corrupted {
+			frame.Set(v, render.Red)
+		}
+		for _, v := range path {
+			frame.Set(v, render.Green)
+		}
+		frame.Label = fmt.Sprintf("%d bytes fallen, path length %d", len(corrupted), len(path)-1)
+		if path == nil {
+			frame.Label = fmt.Sprintf("%d bytes fallen, the exit is cut off", len(corrupted))
+		}
+		a.Add(frame)
+	})
+	return nil
+}
+
+func init() {
+	solver.Register(18, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,66 @@
+package day18
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/internal/benchtest"
+)
+
+const example = `5,4
+4,2
+4,5
+3,0
+2,1
+6,3
+2,4
+1,5
+0,6
+3,3
+2,6
+5,1
+1,2
+5,5
+2,5
+6,5
+1,4
+0,4
+6,4
+1,1
+6,1
+1,0
+0,5
+1,6
+2,0
+`
+
+// The example uses a 7x7 memory space, and only the first 12 bytes
+func TestExample(t *testing.T) {
+	data, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapSize := Vec{X: 7, Y: 7}
+
+	if got := shortestPath(data, 12, mapSize); got != 22 {
+		t.Errorf("shortestPath() = %d, want 22", got)
+	}
+
+	blocking, found := firstBlocking(data, 12, mapSize, nil)
+	if !found || blocking != (Vec{X: 6, Y: 1}) {
+		t.Errorf("firstBlocking() = %v, %v, want {6 1}", blocking, found)
+	}
+}
+
+func TestParseInput(t *testing.T) {
+	for _, input := range []string{"1,2,3\n", "1;2\n", "a,1\n"} {
+		if _, err := Parse(strings.NewReader(input)); err == nil {
+			t.Errorf("expected an error for %q", input)
+		}
+	}
+}
+
+// 3450 bytes falling on the 71x71 memory space, as generated by aoc gen, searched with either priority queue
+func BenchmarkParts(b *testing.B) {
+	benchtest.Queues(b, 18)
+}
@@ -0,0 +1,122 @@
+package day19
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+func parseInput(r io.Reader) ([]string, []string, error) {
+	paragraphs, err := input.Paragraphs(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(paragraphs) != 2 {
+		return nil, nil, fmt.Errorf("invalid input file format, should contain patterns, designs seperated by an empty line.")
+	}
+
+	re := regexp.MustCompile(`^[a-z]+$`)
+
+	patterns := input.Fields(paragraphs[0].Text(), ", ")
+	for _, pattern := range patterns {
+		if !re.MatchString(pattern) {
+			return nil, nil, &input.LineError{Line: paragraphs[0].Line, Err: fmt.Errorf("invalid pattern %q, should be [a-z]+ patterns seperated by a comma", pattern)}
+		}
+	}
+
+	designs := paragraphs[1].Lines
+	for i, design := range designs {
+		if !re.MatchString(design) {
+			return nil, nil, &input.LineError{Line: paragraphs[1].Line + i, Err: fmt.Errorf("invalid design %q, should be a [a-z]+ string", design)}
+		}
+	}
+
+	return patterns, designs, nil
+}
+
+func possible(patterns []string, design string) bool {
+	if design == "" {
+		return true
+	}
+
+	// Check each pattern
+	for _, pattern := range patterns {
+		if len(design) >= len(pattern) && design[:len(pattern)] == pattern {
+			if possible(patterns, design[len(pattern):]) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Given a list of substrings, count all the possible ways of arraning them to form the given string
+func countCombinations(patterns []string, design string, cache map[string]int) int {
+	// Edge case, if string is empty, only one way to arrange it.
+	if design == "" {
+		return 1
+	}
+
+	// Check if we already calculted the value for that string, in cache
+	if val, exists := cache[design]; exists {
+		return val
+	}
+
+	count := 0
+	// For each substring, subtract it from the given string, and recursively call on new string (with prefix removed)
+	for _, pattern := range patterns {
+		if len(design) >= len(pattern) && design[:len(pattern)] == pattern {
+			count += countCombinations(patterns, design[len(pattern):], cache)
+
+		}
+	}
+
+	// Add result to cache
+	cache[design] = count
+	return count
+}
+
+// Available towel patterns, and the designs to make
+type Onsen struct {
+	Patterns, Designs []string
+}
+
+// Parse reads the towel patterns, and the designs.
+func Parse(r io.Reader) (Onsen, error) {
+	patterns, designs, err := parseInput(r)
+	if err != nil {
+		return Onsen{}, err
+	}
+	return Onsen{patterns, designs}, nil
+}
+
+// Part1 counts the designs that can be made.
+func Part1(ctx context.Context, o Onsen) (string, error) {
+	sum := 0
+	for _, design := range o.Designs {
+		if possible(o.Patterns, design) {
+			sum++
+		}
+	}
+	return strconv.Itoa(sum), nil
+}
+
+// Part2 sums the number of ways every design can be made.
+func Part2(ctx context.Context, o Onsen) (string, error) {
+	sum := 0
+	for _, design := range o.Designs {
+		cache := make(map[string]int)
+		sum += countCombinations(o.Patterns, design, cache)
+	}
+	return strconv.Itoa(sum), nil
+}
+
+func init() {
+	solver.Register(19, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,119 @@
+package day19
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/gen"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/difftest"
+)
+
+const example = `r, wr, b, g, bwu, rb, gb, br
+
+brwrr
+bggr
+gbbr
+rrbgbr
+ubwu
+bwurrg
+brgr
+bbrgwb
+`
+
+func TestExample(t *testing.T) {
+	tests := []struct {
+		name string
+		part func(context.Context, Onsen) (string, error)
+		want string
+	}{
+		{"part 1", Part1, "6"},
+		{"part 2", Part2, "16"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := Parse(strings.NewReader(example))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tt.part(context.Background(), o)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseInput(t *testing.T) {
+	for _, newline := range []string{"\n", "\r\n"} {
+		patterns, designs, err := parseInput(strings.NewReader(strings.ReplaceAll(example, "\n", newline)))
+		if err != nil {
+			t.Fatalf("%q: %v", newline, err)
+		}
+		if len(patterns) != 8 || len(designs) != 8 {
+			t.Errorf("%q: parsed %d patterns and %d designs, want 8 and 8", newline, len(patterns), len(designs))
+		}
+	}
+
+	if _, _, err := parseInput(strings.NewReader("r, wr\n\nbrwrr\nbr-wr\n")); err == nil || !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("error = %v, want an error on line 4", err)
+	}
+}
+
+func TestCountCombinations(t *testing.T) {
+	patterns := []string{"r", "wr", "b", "g", "bwu", "rb", "gb", "br"}
+	tests := []struct {
+		design string
+		want   int
+	}{
+		{"brwrr", 2},
+		{"bggr", 1},
+		{"gbbr", 4},
+		{"rrbgbr", 6},
+		{"ubwu", 0},
+		{"bwurrg", 1},
+		{"brgr", 2},
+		{"bbrgwb", 0},
+	}
+
+	for _, tt := range tests {
+		if got := countCombinations(patterns, tt.design, make(map[string]int)); got != tt.want {
+			t.Errorf("countCombinations(%q) = %d, want %d", tt.design, got, tt.want)
+		}
+		if got := possible(patterns, tt.design); got != (tt.want > 0) {
+			t.Errorf("possible(%q) = %v", tt.design, got)
+		}
+	}
+}
+
+// Designs can be made exactly when there's at least a way to make them
+func FuzzPossible(f *testing.F) {
+	for seed := range uint64(8) {
+		f.Add(seed, uint8(10))
+	}
+	f.Fuzz(func(t *testing.T, seed uint64, n uint8) {
+		input := gen.Towels(gen.New(seed), int(n%32)+1)
+		difftest.Check(t, input, possibleAgrees, difftest.Lines, difftest.Fields(", "))
+	})
+}
+
+func possibleAgrees(input string) error {
+	o, err := Parse(strings.NewReader(input))
+	if err != nil {
+		return nil
+	}
+
+	for _, design := range o.Designs {
+		ways := countCombinations(o.Patterns, design, make(map[string]int))
+		if got := possible(o.Patterns, design); got != (ways > 0) {
+			return fmt.Errorf("possible(%q) = %v, but there are %d ways to make it", design, got, ways)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package day20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+	"github.com/SpicyHolo/advent_of_code_2024/search"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+type Vec = geom.Vec
+
+// Parses the racetrack into an occupancy grid
+func Parse(r io.Reader) (*grid.Grid[byte], error) {
+	occupancyGrid, err := input.Grid(r)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read input: %w", err)
+	}
+	return occupancyGrid, nil
+}
+
+// Get adjacent nodes, checking for bounds and if are not walls
+func getAdj(curVec Vec, occupancy *grid.Grid[byte]) []Vec {
+	var adjacent []Vec
+
+	for newVec := range occupancy.Neighbours4(curVec) {
+		// Check if new position is a wall
+		if occupancy.At(newVec) == '#' {
+			continue
+		}
+
+		adjacent = append(adjacent, newVec)
+	}
+
+	return adjacent
+}
+
+// Steps to the adjacent free positions
+func steps(occupancy *grid.Grid[byte]) search.Neighbours[Vec] {
+	return func(curVec Vec) []search.Edge[Vec] {
+		return search.Unit(getAdj(curVec, occupancy)...)
+	}
+}
+
+// Search for the shortest path in a graph
+// MaxCost can be -1, to return any shorest path or a positive value, to report a path costing more as not found.
+// The search itself isn't cut short by maxCost.
+func Djikstra(start, end Vec, occupancy *grid.Grid[byte], maxCost int) ([]Vec, int) {
+	res := search.Dijkstra(start, steps(occupancy), func(curPos Vec) bool { return curPos == end })
+
+	// No path found
+	if !res.Found || (maxCost != -1 && res.Dist[end] > maxCost) {
+		return nil, -1
+	}
+	return res.Path(end), res.Dist[end]
+}
+
+// BFS, returns the map of all reachable positionsfrom the start as keys, and distance to them as values
+func BFS(start Vec, occupancyGrid *grid.Grid[byte]) map[Vec]int {
+	return search.BFS(start, steps(occupancyGrid), nil).Dist
+}
+
+// Find start and end positions from the input grid
+func findStartEnd(occupancyGrid *grid.Grid[byte]) (Vec, Vec) {
+	start, _ := occupancyGrid.Find('S')
+	end, _ := occupancyGrid.Find('E')
+	occupancyGrid.Set(start, '.')
+	occupancyGrid.Set(end, '.')
+	return start, end
+}
+
+var errNoPath = errors.New("no path found from start to end")
+
+// Counts the cheats that save at least minSaving picoseconds, by removing a single wall at a time
+// Reports its progress row by row through ctx
+func countWallCheats(ctx context.Context, occupancyGrid *grid.Grid[byte], minSaving int) (int, error) {
+	/* First find the base path length */
+	// Find start, end
+	start, end := findStartEnd(occupancyGrid)
+
+	// with BFS get the cost from the start and to the end of every reachable position
+	fromstart := BFS(start, occupancyGrid)
+	fromend := BFS(end, occupancyGrid)
+
+	path_length, found := fromstart[end]
+	if !found {
+		return 0, errNoPath
+	}
+
+	/* Now find all cheating paths, that save at least minSaving picoseconds */
+	maxCost := path_length - minSaving
+	numPaths := 0
+
+	// Try removing each wall. The best path through it comes from a free neighbour and leaves to another one,
+	// so it costs the way to the first one, 2 steps, and the way from the second one.
+	progress := solver.Track(ctx, occupancyGrid.Height()-2)
+	for y := 1; y < occupancyGrid.Height()-1; y++ {
+		if err := ctx.Err(); err != nil {
+			return numPaths, progress.Partial(strconv.Itoa(numPaths), err)
+		}
+		for x := 1; x < occupancyGrid.Width()-1; x++ {
+			wall := Vec{X: x, Y: y}
+			if occupancyGrid.At(wall) != '#' {
+				continue
+			}
+
+			// No cheat saves more than the whole race
+			best := path_length
+			for in := range occupancyGrid.Neighbours4(wall) {
+				for out := range occupancyGrid.Neighbours4(wall) {
+					toIn, okIn := fromstart[in]
+					fromOut, okOut := fromend[out]
+					if okIn && okOut {
+						best = min(best, toIn+2+fromOut)
+					}
+				}
+			}
+			if best <= maxCost {
+				numPaths++
+			}
+		}
+		progress.Add(1)
+	}
+	return numPaths, nil
+}
+
+// Counts the cheats lasting up to cheatTime, that save at least minSaving picoseconds
+func countCheats(ctx context.Context, occupancyGrid *grid.Grid[byte], cheatTime, minSaving int) (int, error) {
+	/* First find the base path length */
+	// Find start, end
+	start, end := findStartEnd(occupancyGrid)
+
+	_, path_length := Djikstra(start, end, occupancyGrid, -1)
+
+	if path_length == -1 {
+		return 0, errNoPath
+	}
+
+	/* Now find all cheating paths, that save at least minSaving picoseconds */
+	maxCost := path_length - minSaving
+	numPaths := 0
+
+	// with BFS get all posisble reachable positions from start and end, with their cost
+	fromstart := BFS(start, occupancyGrid)
+	fromend := BFS(end, occupancyGrid)
+
+	// For all possible combinations of those, check if manhattan distance between them is less than cheating time.
+	// If the total distance with cheating is less than maximum allowable cost, count it as a solution.
+	// @Neil Thistlethwaite
+	progress := solver.Track(ctx, len(fromstart))
+	for pos1 := range fromstart {
+		if err := ctx.Err(); err != nil {
+			return numPaths, progress.Partial(strconv.Itoa(numPaths), err)
+		}
+		for pos2 := range fromend {
+			if d := geom.Manhattan(pos1, pos2); d <= cheatTime {
+				if fromstart[pos1]+d+fromend[pos2] <= maxCost {
+					numPaths++
+				}
+			}
+		}
+		progress.Add(1)
+	}
+
+	return numPaths, nil
+}
+
+// Part1 counts the 2 picosecond cheats, that save at least 100 picoseconds.
+func Part1(ctx context.Context, occupancyGrid *grid.Grid[byte]) (string, error) {
+	numPaths, err := countWallCheats(ctx, occupancyGrid, 100)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(numPaths), nil
+}
+
+// Part2 counts the cheats of up to 20 picoseconds, that save at least 100 picoseconds.
+func Part2(ctx context.Context, occupancyGrid *grid.Grid[byte]) (string, error) {
+	numPaths, err := countCheats(ctx, occupancyGrid, 20, 100)
+	if err != nil {
+		return "", err
+	}
+	return strconv.Itoa(numPaths), nil
+}
+
+// Animate runs the race track from start to end, one picosecond at a time.
+func Animate(occupancyGrid *grid.Grid[byte], a render.Sink) error {
+	start, end := findStartEnd(occupancyGrid)
+	path, length := Djikstra(start, end, occupancyGrid, -1)
+	if length == -1 {
+		return errNoPath
+	}
+
+	for i, pos := range path {
+		frame := render.GridFrame(occupancyGrid, func(_ Vec, char byte) uint8 {
+			if char == '#' {
+				return render.White
+			}
+			return render.Black
+		})
+		for _, prev := range path[:i] {
+			frame.Set(prev, render.Grey)
+		}
+		frame.Set(end, render.Red)
+		frame.Set(pos, render.Green)
+		frame.Label = fmt.Sprintf("picosecond %d/%d", i, length)
+		a.Add(frame)
+	}
+	return nil
+}
+
+func init() {
+	solver.Register(20, solver.New(Parse, Part1, Part2))
+}
@@ -0,0 +1,200 @@
+package day20
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/gen"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/benchtest"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/difftest"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+const example = `###############
+#...#...#.....#
+#.#.#.#.#.###.#
+#S#...#.#.#...#
+#######.#.#.###
+#######.#.#...#
+#######.#.###.#
+###..E#...#...#
+###.#######.###
+#...###...#...#
+#.#####.#.###.#
+#.#...#.#.#...#
+#.#.#.#.#.#.###
+#...#...#...###
+###############
+`
+
+func TestCountWallCheats(t *testing.T) {
+	tests := []struct {
+		minSaving, want int
+	}{
+		{64, 1},
+		{40, 2},
+		{20, 5},
+		{2, 44},
+	}
+
+	for _, tt := range tests {
+		racetrack, err := Parse(strings.NewReader(example))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := countWallCheats(context.Background(), racetrack, tt.minSaving)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("countWallCheats(%d) = %d, want %d", tt.minSaving, got, tt.want)
+		}
+	}
+}
+
+func TestCountCheats(t *testing.T) {
+	tests := []struct {
+		cheatTime, minSaving, want int
+	}{
+		{2, 64, 1},
+		{2, 2, 44},
+		{20, 76, 3},
+		{20, 74, 7},
+		{20, 50, 285},
+	}
+
+	for _, tt := range tests {
+		racetrack, err := Parse(strings.NewReader(example))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := countCheats(context.Background(), racetrack, tt.cheatTime, tt.minSaving)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("countCheats(%d, %d) = %d, want %d", tt.cheatTime, tt.minSaving, got, tt.want)
+		}
+	}
+}
+
+func TestCancelled(t *testing.T) {
+	racetrack, err := Parse(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Part1(ctx, racetrack)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("part1() error = %v, want %v", err, context.Canceled)
+	}
+	var partial *solver.PartialError
+	if !errors.As(err, &partial) || partial.Answer != "0" || partial.Progress.Total != 13 {
+		t.Errorf("part1() error = %#v, want a partial answer of 0 after none of the 13 rows", err)
+	}
+}
+
+// Removing walls, and pairing positions at most 2 apart, find the same cheats
+func FuzzCheats(f *testing.F) {
+	for seed := range uint64(8) {
+		f.Add(seed, uint8(15), uint8(4))
+	}
+	f.Fuzz(func(t *testing.T, seed uint64, size, minSaving uint8) {
+		input := gen.Racetrack(gen.New(seed), int(size%32))
+		check := func(input string) error { return cheatsAgree(input, int(minSaving%64)+1) }
+		difftest.Check(t, input, check, difftest.Lines, difftest.Columns, shortenTrack)
+	})
+}
+
+func cheatsAgree(input string, minSaving int) error {
+	if !singleTrack(input) {
+		return nil
+	}
+
+	// Both mark the start and the end as track, parse them their own grid
+	walls, _ := Parse(strings.NewReader(input))
+	pairs, _ := Parse(strings.NewReader(input))
+	got, err := countWallCheats(context.Background(), walls, minSaving)
+	if err != nil {
+		return err
+	}
+	want, err := countCheats(context.Background(), pairs, 2, minSaving)
+	if err != nil {
+		return err
+	}
+	if got != want {
+		return fmt.Errorf("saving at least %d, removing walls finds %d cheats, pairing positions %d", minSaving, got, want)
+	}
+	return nil
+}
+
+// Like the puzzle, a single track from S to E, surrounded by walls
+func singleTrack(input string) bool {
+	g, err := Parse(strings.NewReader(input))
+	if err != nil || len(g.FindAll('S')) != 1 || len(g.FindAll('E')) != 1 {
+		return false
+	}
+
+	track := 0
+	for p, c := range g.All() {
+		if c == '#' {
+			continue
+		}
+		if p.X == 0 || p.Y == 0 || p.X == g.Width()-1 || p.Y == g.Height()-1 {
+			return false
+		}
+
+		want := 2
+		if c == 'S' || c == 'E' {
+			want = 1
+		}
+		if len(getAdj(p, g)) != want {
+			return false
+		}
+		track++
+	}
+
+	start, _ := g.Find('S')
+	return len(BFS(start, g)) == track
+}
+
+// Moves the start or the end one step along the track
+func shortenTrack(input string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		g, err := Parse(strings.NewReader(input))
+		if err != nil {
+			return
+		}
+
+		for _, end := range []byte{'S', 'E'} {
+			p, ok := g.Find(end)
+			if !ok {
+				continue
+			}
+			for _, next := range getAdj(p, g) {
+				if g.At(next) != '.' {
+					continue
+				}
+				shorter := g.Clone()
+				shorter.Set(p, '#')
+				shorter.Set(next, end)
+				if !yield(shorter.String() + "\n") {
+					return
+				}
+			}
+		}
+	}
+}
+
+// A 141x141 racetrack, as generated by aoc gen. Only finding the length of the race uses a priority queue, the cheats use BFS
+func BenchmarkParts(b *testing.B) {
+	benchtest.Queues(b, 20)
+}
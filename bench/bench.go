@@ -0,0 +1,148 @@
+// Package bench measures every phase of a day's solution (parsing and both parts),
+// recording wall time and allocations, so optimizations can be compared against a baseline.
+package bench
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"runtime"
+	"slices"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Phase holds the measurements of one phase over all runs.
+type Phase struct {
+	Name   string        `json:"name"`
+	Runs   int           `json:"runs"`
+	Mean   time.Duration `json:"mean_ns"`
+	Min    time.Duration `json:"min_ns"`
+	Max    time.Duration `json:"max_ns"`
+	Allocs uint64        `json:"allocs_per_run"`
+	Bytes  uint64        `json:"bytes_per_run"`
+}
+
+// Day holds the phases measured for a single day.
+type Day struct {
+	Day    int     `json:"day"`
+	Phases []Phase `json:"phases"`
+}
+
+// Phase returns the measurements of the named phase.
+func (d Day) Phase(name string) (Phase, bool) {
+	i := slices.IndexFunc(d.Phases, func(p Phase) bool { return p.Name == name })
+	if i == -1 {
+		return Phase{}, false
+	}
+	return d.Phases[i], true
+}
+
+// Report is the result of benchmarking a set of days.
+type Report struct {
+	GoVersion string `json:"go_version"`
+	Days      []Day  `json:"days"`
+}
+
+// NewReport creates an empty report for the running Go version.
+func NewReport() *Report {
+	return &Report{GoVersion: runtime.Version()}
+}
+
+// Day returns the measurements of the given day.
+func (r *Report) Day(day int) (Day, bool) {
+	i := slices.IndexFunc(r.Days, func(d Day) bool { return d.Day == day })
+	if i == -1 {
+		return Day{}, false
+	}
+	return r.Days[i], true
+}
+
+// Run benchmarks a day's parse, part1 and part2 phases, running each of them runs times.
+// The input is parsed again before every run of a part, since parts may modify it, but that parse is not measured.
+func Run(day int, data []byte, runs int) (Day, error) {
+	if runs < 1 {
+		return Day{}, fmt.Errorf("number of runs should be at least 1, got %d", runs)
+	}
+
+	s, ok := solver.Get(day)
+	if !ok {
+		return Day{}, fmt.Errorf("no solver registered for day %d", day)
+	}
+
+	res := Day{Day: day}
+
+	parse, err := measure("parse", runs, func() (func() error, error) {
+		return func() error {
+			_, err := s.Parse(bytes.NewReader(data))
+			return err
+		}, nil
+	})
+	if err != nil {
+		return Day{}, err
+	}
+	res.Phases = append(res.Phases, parse)
+
+	for part := 1; part <= 2; part++ {
+		solve := s.Part(part)
+		if solve == nil {
+			continue
+		}
+
+		phase, err := measure(fmt.Sprintf("part%d", part), runs, func() (func() error, error) {
+			input, err := s.Parse(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
+			return func() error {
+				_, err := solve(context.Background(), input)
+				return err
+			}, nil
+		})
+		if err != nil {
+			return Day{}, err
+		}
+		res.Phases = append(res.Phases, phase)
+	}
+
+	return res, nil
+}
+
+// measure runs the function returned by setup runs times. Only the returned function is timed, not setup.
+func measure(name string, runs int, setup func() (func() error, error)) (Phase, error) {
+	phase := Phase{Name: name, Runs: runs}
+
+	var total time.Duration
+	var allocs, bytes uint64
+	var before, after runtime.MemStats
+	for i := 0; i < runs; i++ {
+		f, err := setup()
+		if err != nil {
+			return Phase{}, fmt.Errorf("%s: %w", name, err)
+		}
+
+		runtime.ReadMemStats(&before)
+		start := time.Now()
+		err = f()
+		elapsed := time.Since(start)
+		runtime.ReadMemStats(&after)
+
+		if err != nil {
+			return Phase{}, fmt.Errorf("%s: %w", name, err)
+		}
+
+		total += elapsed
+		allocs += after.Mallocs - before.Mallocs
+		bytes += after.TotalAlloc - before.TotalAlloc
+		if i == 0 || elapsed < phase.Min {
+			phase.Min = elapsed
+		}
+		phase.Max = max(phase.Max, elapsed)
+	}
+
+	phase.Mean = total / time.Duration(runs)
+	phase.Allocs = allocs / uint64(runs)
+	phase.Bytes = bytes / uint64(runs)
+	return phase, nil
+}
@@ -0,0 +1,125 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Out of the puzzle range, so it never clashes with a real day
+const fakeDay = 100
+
+func init() {
+	parse := func(r io.Reader) ([]byte, error) { return io.ReadAll(r) }
+	part1 := func(_ context.Context, data []byte) (string, error) { return strings.ToUpper(string(data)), nil }
+	solver.Register(fakeDay, solver.New(parse, part1, nil))
+}
+
+func TestRun(t *testing.T) {
+	res, err := Run(fakeDay, []byte("abc"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Phases) != 2 {
+		t.Fatalf("measured %d phases, want parse and part1", len(res.Phases))
+	}
+	for _, p := range res.Phases {
+		if p.Runs != 3 || p.Min > p.Mean || p.Mean > p.Max {
+			t.Errorf("phase %s: runs %d, min %v, mean %v, max %v", p.Name, p.Runs, p.Min, p.Mean, p.Max)
+		}
+	}
+	if _, ok := res.Phase("part2"); ok {
+		t.Error("measured part2, that the solver doesn't have")
+	}
+
+	if _, err := Run(fakeDay, nil, 0); err == nil {
+		t.Error("expected an error for 0 runs")
+	}
+	if _, err := Run(fakeDay+1, nil, 1); err == nil {
+		t.Error("expected an error for an unregistered day")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	report := func(mean time.Duration, allocs uint64) *Report {
+		return &Report{Days: []Day{{Day: 20, Phases: []Phase{{Name: "part1", Mean: mean, Allocs: allocs}}}}}
+	}
+	baseline := report(time.Second, 100)
+
+	tests := []struct {
+		name    string
+		current *Report
+		want    []string
+	}{
+		{"unchanged", report(time.Second, 100), nil},
+		{"within threshold", report(1050*time.Millisecond, 105), nil},
+		{"faster", report(time.Millisecond, 10), nil},
+		{"slower", report(2*time.Second, 100), []string{"time"}},
+		{"more allocations", report(time.Second, 200), []string{"allocs"}},
+		{"missing from baseline", &Report{Days: []Day{{Day: 1, Phases: []Phase{{Name: "part1", Mean: time.Hour}}}}}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Compare(baseline, tt.current, 0.1)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Compare() = %v, want regressions in %v", got, tt.want)
+			}
+			for i, r := range got {
+				if r.Metric != tt.want[i] {
+					t.Errorf("regression %d is in %s, want %s", i, r.Metric, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	report := NewReport()
+	res, err := Run(fakeDay, []byte("abc"), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report.Days = append(report.Days, res)
+
+	var buf bytes.Buffer
+	if err := report.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := ReadJSON(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if regressions := Compare(report, loaded, 0); len(regressions) != 0 {
+		t.Errorf("loaded report differs from the written one: %v", regressions)
+	}
+	if loaded.GoVersion != report.GoVersion {
+		t.Errorf("go version = %q, want %q", loaded.GoVersion, report.GoVersion)
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	report := &Report{Days: []Day{{Day: 7, Phases: []Phase{
+		{Name: "parse", Runs: 2, Mean: 1500 * time.Nanosecond, Min: time.Microsecond, Max: 2 * time.Microsecond, Allocs: 3, Bytes: 64},
+	}}}}
+
+	var buf bytes.Buffer
+	if err := report.WriteMarkdown(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("table has %d lines, want header, separator and one row:\n%s", len(lines), buf.String())
+	}
+	if want := "| 07 | parse | 2 | 2µs | 1µs | 2µs | 3 | 64 |"; lines[2] != want {
+		t.Errorf("row = %q, want %q", lines[2], want)
+	}
+}
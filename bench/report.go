@@ -0,0 +1,105 @@
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+)
+
+// WriteJSON writes the report as indented JSON.
+func (r *Report) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// ReadJSON loads a report written by WriteJSON.
+func ReadJSON(rd io.Reader) (*Report, error) {
+	var r Report
+	if err := json.NewDecoder(rd).Decode(&r); err != nil {
+		return nil, fmt.Errorf("could not decode report: %w", err)
+	}
+	return &r, nil
+}
+
+// WriteMarkdown writes the report as a Markdown table, one row per phase.
+func (r *Report) WriteMarkdown(w io.Writer) error {
+	if _, err := fmt.Fprintln(w, "| Day | Phase | Runs | Mean | Min | Max | Allocs/run | Bytes/run |"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "|----:|-------|-----:|-----:|----:|----:|-----------:|----------:|"); err != nil {
+		return err
+	}
+
+	for _, d := range r.Days {
+		for _, p := range d.Phases {
+			_, err := fmt.Fprintf(w, "| %02d | %s | %d | %v | %v | %v | %d | %d |\n",
+				d.Day, p.Name, p.Runs, round(p.Mean), round(p.Min), round(p.Max), p.Allocs, p.Bytes)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Keeps the table readable, nanoseconds are just noise here
+func round(d time.Duration) time.Duration {
+	return d.Round(time.Microsecond)
+}
+
+// Regression is a phase that got slower, or allocates more, than in the baseline.
+type Regression struct {
+	Day      int
+	Phase    string
+	Metric   string // "time" or "allocs"
+	Old, New float64
+}
+
+func (r Regression) String() string {
+	change := "new"
+	if r.Old != 0 {
+		change = fmt.Sprintf("%+.1f%%", (r.New/r.Old-1)*100)
+	}
+	if r.Metric == "time" {
+		return fmt.Sprintf("day %02d %s: time %v -> %v (%s)",
+			r.Day, r.Phase, round(time.Duration(r.Old)), round(time.Duration(r.New)), change)
+	}
+	return fmt.Sprintf("day %02d %s: %s %.0f -> %.0f (%s)", r.Day, r.Phase, r.Metric, r.Old, r.New, change)
+}
+
+// Compare checks every phase of the current report against the baseline.
+// A phase regresses if its mean time or allocations grew by more than threshold (0.1 is 10%).
+// Days and phases missing from the baseline are skipped.
+func Compare(baseline, current *Report, threshold float64) []Regression {
+	var res []Regression
+	for _, d := range current.Days {
+		old, ok := baseline.Day(d.Day)
+		if !ok {
+			continue
+		}
+
+		for _, p := range d.Phases {
+			oldPhase, ok := old.Phase(p.Name)
+			if !ok {
+				continue
+			}
+
+			if regressed(float64(oldPhase.Mean), float64(p.Mean), threshold) {
+				res = append(res, Regression{d.Day, p.Name, "time", float64(oldPhase.Mean), float64(p.Mean)})
+			}
+			if regressed(float64(oldPhase.Allocs), float64(p.Allocs), threshold) {
+				res = append(res, Regression{d.Day, p.Name, "allocs", float64(oldPhase.Allocs), float64(p.Allocs)})
+			}
+		}
+	}
+	return res
+}
+
+func regressed(old, new, threshold float64) bool {
+	if old == 0 {
+		return new > 0
+	}
+	return new > old*(1+threshold)
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/ledger"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Statuses of the summary, besides the ones of the ledger
+const (
+	statusFailed  = "FAILED"
+	statusTimeout = "TIMEOUT"
+	statusSkipped = "SKIPPED"
+)
+
+// How long a solver gets to return its partial answer, once its time is up
+const timeoutGrace = time.Second
+
+// A part to solve, and how it went
+type job struct {
+	day, part int
+	data      []byte
+	res       solver.Result
+	status    string
+}
+
+// Solves the parts of every day with an input, workers at a time, and prints a summary table.
+// Every part gets timeout to finish, 0 for no limit.
+func runAll(ctx context.Context, parts []int, l *ledger.Ledger, workers int, timeout time.Duration) error {
+	var jobs []*job
+	for _, d := range solver.Days() {
+		data, err := readInput("", d)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		for _, p := range parts {
+			j := &job{day: d, part: p, data: data}
+			if err != nil {
+				j.status, j.res.Err = statusSkipped, err
+			}
+			jobs = append(jobs, j)
+		}
+	}
+
+	// A part keeps its slot until its solver returns, even past its timeout, so no more than workers solvers ever run
+	slots := make(chan struct{}, max(workers, 1))
+	var wg sync.WaitGroup
+	for _, j := range jobs {
+		if j.status == statusSkipped {
+			continue
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			j.res = solver.Result{Day: j.day, Part: j.part, Err: ctx.Err()}
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			j.res = solveWithin(ctx, j.day, j.part, j.data, timeout, func() { <-slots })
+		}()
+	}
+	wg.Wait()
+
+	failed, changed := false, false
+	for _, j := range jobs {
+		var partial *solver.PartialError
+		switch {
+		case j.status == statusSkipped:
+		case errors.Is(j.res.Err, context.DeadlineExceeded):
+			j.status, failed = statusTimeout, true
+			if errors.As(j.res.Err, &partial) {
+				j.res.Answer = partial.Answer + " (partial)"
+			}
+		case j.res.Err != nil:
+			j.status, failed = statusFailed, true
+		default:
+			status, _ := l.Check(j.day, j.part, ledger.Hash(j.data), j.res.Answer)
+			j.status, changed = status.String(), changed || status == ledger.Changed
+		}
+	}
+
+	if err := writeSummary(jobs); err != nil {
+		return err
+	}
+	for _, j := range jobs {
+		if j.res.Err != nil && j.status != statusSkipped {
+			fmt.Fprintf(os.Stderr, "day %02d part %d: %v\n", j.day, j.part, j.res.Err)
+		}
+	}
+
+	switch {
+	case failed:
+		return errFailed
+	case changed:
+		return errChanged
+	}
+	return nil
+}
+
+// Solves a part, giving up after timeout even if the solver doesn't check its context.
+// Such a solver is left running in the background, release is called once the solver has returned.
+func solveWithin(ctx context.Context, day, part int, data []byte, timeout time.Duration, release func()) solver.Result {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	done := make(chan solver.Result, 1)
+	go func() {
+		defer release()
+		defer func() {
+			if r := recover(); r != nil {
+				done <- solver.Result{Day: day, Part: part, Duration: time.Since(start), Err: fmt.Errorf("panic: %v", r)}
+			}
+		}()
+		done <- solver.Run(ctx, day, part, data)
+	}()
+
+	select {
+	case res := <-done:
+		return res
+	case <-ctx.Done():
+	}
+
+	select {
+	case res := <-done:
+		return res
+	case <-time.After(timeoutGrace):
+		return solver.Result{Day: day, Part: part, Duration: time.Since(start), Err: ctx.Err()}
+	}
+}
+
+func writeSummary(jobs []*job) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DAY\tPART\tANSWER\tTIME\tSTATUS")
+	for _, j := range jobs {
+		duration := "-"
+		if j.status != statusSkipped {
+			duration = j.res.Duration.Round(100 * time.Microsecond).String()
+		}
+		fmt.Fprintf(w, "%02d\t%d\t%s\t%s\t%s\n", j.day, j.part, cmp.Or(j.res.Answer, "-"), duration, j.status)
+	}
+	return w.Flush()
+}
+
+// Memory size in bytes, with an optional unit: 512MiB, 2GB, ...
+type byteSize int64
+
+var byteUnits = []struct {
+	suffix string
+	size   float64
+}{
+	{"KiB", 1 << 10}, {"MiB", 1 << 20}, {"GiB", 1 << 30},
+	{"KB", 1e3}, {"MB", 1e6}, {"GB", 1e9},
+	{"B", 1},
+}
+
+func (b *byteSize) String() string {
+	if b == nil || *b == 0 {
+		return "0"
+	}
+	return strconv.FormatInt(int64(*b), 10) + "B"
+}
+
+func (b *byteSize) Set(s string) error {
+	number, size := s, 1.0
+	for _, u := range byteUnits {
+		if strings.HasSuffix(s, u.suffix) {
+			number, size = strings.TrimSuffix(s, u.suffix), u.size
+			break
+		}
+	}
+
+	n, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if err != nil || n < 0 {
+		return fmt.Errorf("invalid size %q, should be a number of bytes, with an optional unit such as MiB or GB", s)
+	}
+	*b = byteSize(n * size)
+	return nil
+}
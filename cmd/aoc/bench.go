@@ -0,0 +1,114 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"github.com/SpicyHolo/advent_of_code_2024/bench"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+var errRegressed = errors.New("benchmarks regressed")
+
+func benchCmd(args []string) error {
+	flags := flag.NewFlagSet("bench", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to benchmark, every day with an input.txt if 0")
+	runs := flags.Int("n", 10, "number of runs of every phase")
+	inputPath := flags.String("input", "", "puzzle input file, - for stdin, only with -day (default <day>/input.txt)")
+	jsonPath := flags.String("json", "", "write the report as JSON to this file")
+	mdPath := flags.String("md", "", "write the Markdown table to this file instead of stdout")
+	baselinePath := flags.String("compare", "", "compare against a JSON report, and fail on regressions")
+	threshold := flags.Float64("threshold", 0.1, "allowed slowdown before flagging a regression, 0.1 is 10%")
+	setupLog := cli.LogFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	days := solver.Days()
+	if *day != 0 {
+		if _, ok := solver.Get(*day); !ok {
+			return fmt.Errorf("no solver for day %d, available days: %v", *day, days)
+		}
+		days = []int{*day}
+	} else if *inputPath != "" {
+		return errors.New("-input can only be used with -day")
+	}
+
+	// Load the baseline first, so a typo doesn't waste a whole benchmark run
+	var baseline *bench.Report
+	if *baselinePath != "" {
+		f, err := os.Open(*baselinePath)
+		if err != nil {
+			return fmt.Errorf("could not open baseline: %w", err)
+		}
+		baseline, err = bench.ReadJSON(f)
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	report := bench.NewReport()
+	for _, d := range days {
+		data, err := readInput(*inputPath, d)
+		if errors.Is(err, fs.ErrNotExist) && *day == 0 {
+			fmt.Fprintf(os.Stderr, "skipping day %02d: %v\n", d, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		res, err := bench.Run(d, data, *runs)
+		if err != nil {
+			return fmt.Errorf("day %02d: %w", d, err)
+		}
+		report.Days = append(report.Days, res)
+	}
+
+	if err := writeReport(*mdPath, report.WriteMarkdown); err != nil {
+		return err
+	}
+	if *jsonPath != "" {
+		if err := writeReport(*jsonPath, report.WriteJSON); err != nil {
+			return err
+		}
+	}
+
+	if baseline == nil {
+		return nil
+	}
+	regressions := bench.Compare(baseline, report, *threshold)
+	for _, r := range regressions {
+		fmt.Fprintln(os.Stderr, "regression:", r)
+	}
+	if len(regressions) > 0 {
+		return errRegressed
+	}
+	return nil
+}
+
+// Writes to the file at path, or to stdout if path is empty
+func writeReport(path string, write func(w io.Writer) error) error {
+	if path == "" {
+		return write(os.Stdout)
+	}
+
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create report: %w", err)
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return fmt.Errorf("could not write report: %w", err)
+	}
+	return f.Close()
+}
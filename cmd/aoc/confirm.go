@@ -0,0 +1,61 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+	"github.com/SpicyHolo/advent_of_code_2024/ledger"
+)
+
+// Solves the parts again, and records their answers as the confirmed ones
+func confirmCmd(args []string) error {
+	fs := flag.NewFlagSet("confirm", flag.ContinueOnError)
+	day := fs.Int("day", 0, "day to confirm (1-25)")
+	part := fs.Int("part", 0, "part to confirm (1 or 2), both if 0")
+	inputPath := fs.String("input", "", "puzzle input file, - for stdin (default <day>/input.txt)")
+	ledgerPath := fs.String("ledger", defaultLedger, "file with the confirmed answers")
+	timeout := fs.Duration("timeout", 0, "stop solving after this long, 0 for no limit (Ctrl-C stops too)")
+	setupLog := cli.LogFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	parts, err := selectParts(*day, *part)
+	if err != nil {
+		return err
+	}
+
+	data, err := readInput(*inputPath, *day)
+	if err != nil {
+		return err
+	}
+
+	l, err := ledger.Load(*ledgerPath)
+	if err != nil {
+		return err
+	}
+	input := ledger.Hash(data)
+
+	ctx, cancel := cli.Context(*timeout)
+	defer cancel()
+
+	for _, p := range parts {
+		res := solve(ctx, *day, p, data)
+		if res.Err != nil {
+			return fmt.Errorf("day %02d part %d: %w", *day, p, res.Err)
+		}
+
+		if status, confirmed := l.Check(*day, p, input, res.Answer); status == ledger.Changed {
+			fmt.Printf("day %02d part %d: %s, replacing %s\n", *day, p, res.Answer, confirmed)
+		} else {
+			fmt.Printf("day %02d part %d: %s confirmed\n", *day, p, res.Answer)
+		}
+		l.Confirm(*day, p, input, res.Answer)
+	}
+
+	return l.Save()
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/fetch"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+func fetchCmd(args []string) error {
+	flags := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to download, every registered day if 0")
+	year := flags.Int("year", fetch.DefaultYear, "puzzle year")
+	cacheDir := flags.String("cache", ".", "directory the inputs are stored in, as <cache>/<day>/input.txt (<cache>/<year>/<day>/input.txt for other years)")
+	sessionFile := flags.String("session-file", defaultSessionFile(), "file holding the session cookie, if "+fetch.SessionEnv+" is not set")
+	interval := flags.Duration("interval", 5*time.Second, "minimum time between two downloads")
+	setupLog := cli.LogFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	days := solver.Days()
+	if *day != 0 {
+		days = []int{*day}
+	}
+
+	// A missing session only matters once something has to be downloaded
+	session, err := fetch.Session(*sessionFile)
+	if err != nil && !errors.Is(err, fetch.ErrNoSession) {
+		return err
+	}
+
+	client := fetch.NewClient(session, *cacheDir)
+	client.Year = *year
+	client.MinInterval = *interval
+
+	for _, d := range days {
+		data, cached, err := client.Input(context.Background(), d)
+		if err != nil {
+			return fmt.Errorf("day %02d: %w", d, err)
+		}
+
+		status := "downloaded"
+		if cached {
+			status = "cached"
+		}
+		fmt.Printf("day %02d: %s, %d bytes (%s)\n", d, client.CachePath(d), len(data), status)
+	}
+	return nil
+}
+
+// ~/.config/aoc/session, or nothing if there is no config directory
+func defaultSessionFile() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "aoc", "session")
+}
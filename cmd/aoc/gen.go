@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/SpicyHolo/advent_of_code_2024/gen"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func genCmd(args []string) error {
+	flags := flag.NewFlagSet("gen", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to generate an input for")
+	seed := flags.Uint64("seed", 1, "random seed, the same seed and size always give the same input")
+	size := flags.Int("size", 0, "size of the input, what it counts depends on the day (default about the real input)")
+	out := flags.String("o", "", "file to write (default stdout)")
+	setupLog := cli.LogFlags(flags)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "usage: aoc gen -day N [-seed 1] [-size N] [-o file]\n\nsizes:")
+		for _, d := range gen.Days() {
+			g, _ := gen.Get(d)
+			fmt.Fprintf(flags.Output(), "  day %02d: %s (default %d)\n", d, g.Unit, g.Size)
+		}
+		fmt.Fprintln(flags.Output(), "\nflags:")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	data, err := gen.Generate(*day, *seed, *size)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = fmt.Print(data)
+		return err
+	}
+	return os.WriteFile(*out, []byte(data), 0o644)
+}
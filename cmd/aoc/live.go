@@ -0,0 +1,45 @@
+package main
+
+import (
+	"flag"
+
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+	"github.com/SpicyHolo/advent_of_code_2024/live"
+)
+
+func liveCmd(args []string) error {
+	flags := flag.NewFlagSet("live", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to watch")
+	part := flags.Int("part", 1, "part of the puzzle to watch, for days that differ")
+	inputPath := flags.String("input", "", "puzzle input file (default <day>/input.txt), stdin is needed for the keys")
+	fps := flags.Int("fps", 10, "frames per second")
+	history := flags.Int("history", 1000, "frames kept for stepping back")
+	setupLog := cli.LogFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	animate, err := getAnimator(*day)
+	if err != nil {
+		return err
+	}
+
+	data, err := readInput(*inputPath, *day)
+	if err != nil {
+		return err
+	}
+
+	v, err := live.Open(live.Options{FPS: *fps, History: *history})
+	if err != nil {
+		return err
+	}
+	if err := animate(data, *part, v); err != nil {
+		v.Close()
+		return err
+	}
+	v.Wait()
+	return v.Close()
+}
@@ -0,0 +1,77 @@
+// Command aoc runs any day of the advent of code the same way.
+//
+// Usage:
+//
+//	aoc run -day 12 [-part 2] [-input path|-] [-cpuprofile cpu.pprof] [-top 10]
+//	aoc run -all [-j 8] [-timeout 30s] [-memlimit 2GiB]
+//	aoc confirm -day 12 [-part 2] [-input path|-]
+//	aoc bench [-day 12] [-n 10] [-json report.json] [-compare baseline.json]
+//	aoc serve [-addr localhost:8080] [-timeout 30s]
+//	aoc fetch [-day 12] [-cache dir]
+//	aoc render -day 14 [-part 2] [-o day14.gif] [-every 10]
+//	aoc live -day 15 [-part 2] [-fps 10]
+//	aoc gen -day 9 [-seed 1] [-size 200000]
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	_ "github.com/SpicyHolo/advent_of_code_2024/days"
+)
+
+const usage = `usage: aoc <command> [flags]
+
+commands:
+  run      solve a day or -all of them, and check the answers against the confirmed ones
+  confirm  solve a day, and record its answers as confirmed
+  bench    time every phase of the days, and compare against a baseline
+  serve    solve puzzles over a JSON HTTP API
+  fetch    download puzzle inputs into <day>/input.txt
+  render   animate the simulation of a day as a GIF
+  live     watch the simulation of a day in the terminal
+  gen      generate a random input for a day
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "run":
+		err = runCmd(args)
+	case "confirm":
+		err = confirmCmd(args)
+	case "bench":
+		err = benchCmd(args)
+	case "serve":
+		err = serveCmd(args)
+	case "fetch":
+		err = fetchCmd(args)
+	case "render":
+		err = renderCmd(args)
+	case "live":
+		err = liveCmd(args)
+	case "gen":
+		err = genCmd(args)
+	case "help", "-h", "-help", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n%s", cmd, usage)
+		os.Exit(2)
+	}
+
+	if errors.Is(err, flag.ErrHelp) {
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+
+	day06 "github.com/SpicyHolo/advent_of_code_2024/06"
+	day14 "github.com/SpicyHolo/advent_of_code_2024/14"
+	day15 "github.com/SpicyHolo/advent_of_code_2024/15"
+	day16 "github.com/SpicyHolo/advent_of_code_2024/16"
+	day18 "github.com/SpicyHolo/advent_of_code_2024/18"
+	day20 "github.com/SpicyHolo/advent_of_code_2024/20"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+)
+
+// Draws a day's simulation for a part of the puzzle, frame by frame
+type animator func(data []byte, part int, a render.Sink) error
+
+// Days that can be animated
+var animators = map[int]animator{
+	6:  animation(day06.Parse, day06.Animate),
+	14: animation(day14.Parse, day14.Animate),
+	15: func(data []byte, part int, a render.Sink) error {
+		state, err := day15.Parse(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		return day15.Animate(state, a, part == 2)
+	},
+	16: animation(day16.Parse, day16.Animate),
+	18: animation(day18.Parse, day18.Animate),
+	20: animation(day20.Parse, day20.Animate),
+}
+
+// Wraps a day's Parse and Animate into an animator, for days animating both parts the same way
+func animation[T any](parse func(io.Reader) (T, error), animate func(T, render.Sink) error) animator {
+	return func(data []byte, _ int, a render.Sink) error {
+		input, err := parse(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		return animate(input, a)
+	}
+}
+
+func getAnimator(day int) (animator, error) {
+	animate, ok := animators[day]
+	if !ok {
+		return nil, fmt.Errorf("no animation for day %d, available days: %v", day, slices.Sorted(maps.Keys(animators)))
+	}
+	return animate, nil
+}
+
+func renderCmd(args []string) error {
+	flags := flag.NewFlagSet("render", flag.ContinueOnError)
+	day := flags.Int("day", 0, "day to animate")
+	part := flags.Int("part", 1, "part of the puzzle to animate, for days that differ")
+	inputPath := flags.String("input", "", "puzzle input file, - for stdin (default <day>/input.txt)")
+	out := flags.String("o", "", "GIF file to write (default day<day>.gif)")
+	scale := flags.Int("scale", 4, "size of a cell in pixels")
+	delay := flags.Int("delay", 5, "time between frames, in 100ths of a second")
+	every := flags.Int("every", 1, "keep every n-th frame")
+	maxFrames := flags.Int("max", 2000, "maximum number of frames, 0 for no limit")
+	setupLog := cli.LogFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	animate, err := getAnimator(*day)
+	if err != nil {
+		return err
+	}
+
+	data, err := readInput(*inputPath, *day)
+	if err != nil {
+		return err
+	}
+
+	a := render.New(render.Options{Scale: *scale, Delay: *delay, Every: *every, Max: *maxFrames})
+	if err := animate(data, *part, a); err != nil {
+		return err
+	}
+
+	if *out == "" {
+		*out = fmt.Sprintf("day%02d.gif", *day)
+	}
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	if err := a.Encode(f); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("day %02d: %d frames written to %s\n", *day, a.Len(), *out)
+	return nil
+}
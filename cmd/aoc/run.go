@@ -0,0 +1,168 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+	"github.com/SpicyHolo/advent_of_code_2024/ledger"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+var (
+	errFailed  = errors.New("some parts failed")
+	errChanged = errors.New("some answers differ from the ledger, check them and run aoc confirm")
+)
+
+// Where confirmed answers are kept, unless -ledger says otherwise
+const defaultLedger = "answers.json"
+
+func runCmd(args []string) error {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	day := fs.Int("day", 0, "day to solve (1-25)")
+	part := fs.Int("part", 0, "part to solve (1 or 2), both if 0")
+	inputPath := fs.String("input", "", "puzzle input file, - for stdin (default <day>/input.txt)")
+	ledgerPath := fs.String("ledger", defaultLedger, "file with the confirmed answers, the answers are checked against")
+	timeout := fs.Duration("timeout", 0, "stop solving after this long, 0 for no limit (Ctrl-C stops too), per part with -all")
+	all := fs.Bool("all", false, "solve every day with an input.txt, and print a summary table")
+	workers := fs.Int("j", runtime.NumCPU(), "parts solved at the same time with -all, a part running past its timeout counts until its solver returns")
+	var memLimit byteSize
+	fs.Var(&memLimit, "memlimit", "soft memory limit, such as 2GiB, the garbage collector works harder to stay under it, 0 for none")
+	setupLog := cli.LogFlags(fs)
+	startProfile := cli.ProfileFlags(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	if memLimit > 0 {
+		debug.SetMemoryLimit(int64(memLimit))
+	}
+
+	if *all {
+		return runAllCmd(*day, *part, *inputPath, *ledgerPath, *workers, *timeout, startProfile)
+	}
+
+	parts, err := selectParts(*day, *part)
+	if err != nil {
+		return err
+	}
+
+	data, err := readInput(*inputPath, *day)
+	if err != nil {
+		return err
+	}
+
+	l, err := ledger.Load(*ledgerPath)
+	if err != nil {
+		return err
+	}
+	input := ledger.Hash(data)
+
+	ctx, cancel := cli.Context(*timeout)
+	defer cancel()
+
+	stopProfile, err := startProfile(os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	failed, changed := false, false
+	for _, p := range parts {
+		res := solve(ctx, *day, p, data)
+		if res.Err != nil {
+			fmt.Println(res)
+			failed = true
+			continue
+		}
+
+		status, confirmed := l.Check(*day, p, input, res.Answer)
+		switch status {
+		case ledger.Changed:
+			fmt.Printf("%v %v, confirmed answer is %s\n", res, status, confirmed)
+			changed = true
+		default:
+			fmt.Println(res, status)
+		}
+	}
+
+	if err := stopProfile(); err != nil {
+		return err
+	}
+
+	switch {
+	case failed:
+		return errFailed
+	case changed:
+		return errChanged
+	}
+	return nil
+}
+
+func runAllCmd(day, part int, inputPath, ledgerPath string, workers int, timeout time.Duration, startProfile func(io.Writer) (func() error, error)) error {
+	if day != 0 || inputPath != "" {
+		return errors.New("-all solves the input.txt of every day, it can't be used with -day or -input")
+	}
+	parts, err := cli.Parts(part)
+	if err != nil {
+		return err
+	}
+
+	l, err := ledger.Load(ledgerPath)
+	if err != nil {
+		return err
+	}
+
+	// Ctrl-C stops every part, the timeout applies to each part on its own
+	ctx, cancel := cli.Context(0)
+	defer cancel()
+
+	stopProfile, err := startProfile(os.Stderr)
+	if err != nil {
+		return err
+	}
+	err = runAll(ctx, parts, l, workers, timeout)
+	return errors.Join(err, stopProfile())
+}
+
+// Solves a part, showing its progress on stderr
+func solve(ctx context.Context, day, part int, data []byte) solver.Result {
+	progress := cli.NewProgressLine(os.Stderr, fmt.Sprintf("day %02d part %d", day, part))
+	defer progress.Clear()
+	return solver.Run(solver.WithProgress(ctx, progress.Report), day, part, data)
+}
+
+// Checks that the day has a solver, and returns the parts to solve, both if part is 0
+func selectParts(day, part int) ([]int, error) {
+	if _, ok := solver.Get(day); !ok {
+		return nil, fmt.Errorf("no solver for day %d, available days: %v", day, solver.Days())
+	}
+
+	return cli.Parts(part)
+}
+
+// Reads the puzzle input from a file, stdin or the day's default input.txt
+func readInput(path string, day int) ([]byte, error) {
+	switch path {
+	case "-":
+		return io.ReadAll(os.Stdin)
+	case "":
+		path = filepath.Join(fmt.Sprintf("%02d", day), "input.txt")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read input: %w", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+	"github.com/SpicyHolo/advent_of_code_2024/server"
+)
+
+func serveCmd(args []string) error {
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	addr := flags.String("addr", "localhost:8080", "address to listen on")
+	timeout := flags.Duration("timeout", 30*time.Second, "cancel solvers running longer than this, 0 disables it")
+	setupLog := cli.LogFlags(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := setupLog(); err != nil {
+		return err
+	}
+
+	srv := &http.Server{
+		Addr:              *addr,
+		Handler:           server.New(*timeout),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	fmt.Printf("serving the solvers on http://%s\n", *addr)
+	return srv.ListenAndServe()
+}
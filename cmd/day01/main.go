@@ -0,0 +1,77 @@
+// Command day01 solves day 1 of the advent of code.
+// With -stream, both parts are solved in a single pass sorting on disk, for location lists larger than memory.
+// With -matrix, lists of any number of columns are compared pairwise instead.
+// With -report, the sorted lists are printed pair by pair, with the entries driving the distance and the similarity score.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+
+	day01 "github.com/SpicyHolo/advent_of_code_2024/01"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	budget := flag.Int("budget", day01.DefaultBudget, "with -stream, numbers of each column kept in memory before sorting them on disk")
+	stream := cli.StreamMode(1, func(ctx context.Context, r io.Reader) (string, string, error) {
+		distance, similarity, err := day01.Stream(ctx, r, *budget, "")
+		if err != nil {
+			return "", "", err
+		}
+		return strconv.Itoa(distance), strconv.Itoa(similarity), nil
+	})
+
+	metric := flag.String("matrix", "", "compare every pair of columns by their "+day01.Distance+" or "+day01.Similarity+", for lists of any number of columns")
+	format := flag.String("format", "text", "with -matrix, output format: text or csv")
+	header := flag.Bool("header", false, "with -matrix, the first line names the columns")
+	matrix := cli.Mode{
+		Flag:   "matrix",
+		Active: func() bool { return *metric != "" },
+		Run: func(_ context.Context, in io.Reader, out io.Writer, _ int) error {
+			columns, err := day01.ParseColumns(in, *header)
+			if err != nil {
+				return err
+			}
+			m, err := columns.Matrix(*metric)
+			if err != nil {
+				return err
+			}
+			return day01.WriteMatrix(out, columns.Names, m, *format)
+		},
+	}
+
+	reportFormat := flag.String("report", "", "reconcile the two lists: print every sorted pair and the top contributors, as csv or json")
+	contributors := 10
+	flag.Func("contributors", "with -report, number of top contributors to the distance and the similarity score (default 10)", func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid number %q, should be 0 or more", s)
+		}
+		contributors = n
+		return nil
+	})
+	report := cli.Mode{
+		Flag:   "report",
+		Active: func() bool { return *reportFormat != "" },
+		Run: func(_ context.Context, in io.Reader, out io.Writer, _ int) error {
+			lists, err := day01.Parse(in)
+			if err != nil {
+				return err
+			}
+			r := day01.Reconcile(lists, contributors)
+			switch *reportFormat {
+			case "csv":
+				return r.WriteCSV(out)
+			case "json":
+				return r.WriteJSON(out)
+			}
+			return fmt.Errorf("unknown report format %q, should be csv or json", *reportFormat)
+		},
+	}
+
+	cli.Main(1, day01.Parse, day01.Part1, day01.Part2, stream, matrix, report)
+}
@@ -0,0 +1,39 @@
+// Command day02 solves day 2 of the advent of code.
+// The rule of part 2 can be changed, e.g. for a dampener tolerating more faulty levels.
+// With -diagnose, every report is checked against that rule instead, explaining why the unsafe ones are.
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"strconv"
+
+	day02 "github.com/SpicyHolo/advent_of_code_2024/02"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	rule := day02.Dampened
+	flag.IntVar(&rule.MinStep, "min-step", rule.MinStep, "part 2: smallest safe difference between two levels")
+	flag.IntVar(&rule.MaxStep, "max-step", rule.MaxStep, "part 2: largest safe difference between two levels")
+	flag.IntVar(&rule.MaxRemovals, "removals", rule.MaxRemovals, "part 2: levels the dampener can remove from a report")
+
+	part2 := func(ctx context.Context, data [][]int) (string, error) {
+		return strconv.Itoa(day02.CountSafe(data, rule)), nil
+	}
+	format := flag.String("diagnose", "", "explain why every report is safe or not, as jsonl or a table")
+	diagnose := cli.Mode{
+		Flag:   "diagnose",
+		Active: func() bool { return *format != "" },
+		Run: func(_ context.Context, in io.Reader, out io.Writer, _ int) error {
+			data, err := day02.Parse(in)
+			if err != nil {
+				return err
+			}
+			return day02.WriteDiagnoses(out, day02.DiagnoseAll(data, rule), *format)
+		},
+	}
+
+	cli.Main(2, day02.Parse, day02.Part1, part2, diagnose)
+}
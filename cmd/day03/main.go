@@ -0,0 +1,11 @@
+// Command day03 solves day 3 of the advent of code.
+package main
+
+import (
+	day03 "github.com/SpicyHolo/advent_of_code_2024/03"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(3, day03.Parse, day03.Part1, day03.Part2)
+}
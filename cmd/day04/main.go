@@ -0,0 +1,11 @@
+// Command day04 solves day 4 of the advent of code.
+package main
+
+import (
+	day04 "github.com/SpicyHolo/advent_of_code_2024/04"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(4, day04.Parse, day04.Part1, day04.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day05 solves day 5 of the advent of code.
+package main
+
+import (
+	day05 "github.com/SpicyHolo/advent_of_code_2024/05"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(5, day05.Parse, day05.Part1, day05.Part2)
+}
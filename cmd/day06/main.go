@@ -0,0 +1,11 @@
+// Command day06 solves day 6 of the advent of code.
+package main
+
+import (
+	day06 "github.com/SpicyHolo/advent_of_code_2024/06"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(6, day06.Parse, day06.Part1, day06.Part2)
+}
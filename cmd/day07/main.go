@@ -0,0 +1,11 @@
+// Command day07 solves day 7 of the advent of code.
+package main
+
+import (
+	day07 "github.com/SpicyHolo/advent_of_code_2024/07"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(7, day07.Parse, day07.Part1, day07.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day08 solves day 8 of the advent of code.
+package main
+
+import (
+	day08 "github.com/SpicyHolo/advent_of_code_2024/08"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(8, day08.Parse, day08.Part1, day08.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day09 solves day 9 of the advent of code.
+package main
+
+import (
+	day09 "github.com/SpicyHolo/advent_of_code_2024/09"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(9, day09.Parse, day09.Part1, day09.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day10 solves day 10 of the advent of code.
+package main
+
+import (
+	day10 "github.com/SpicyHolo/advent_of_code_2024/10"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(10, day10.Parse, day10.Part1, day10.Part2)
+}
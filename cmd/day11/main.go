@@ -0,0 +1,11 @@
+// Command day11 solves day 11 of the advent of code.
+package main
+
+import (
+	day11 "github.com/SpicyHolo/advent_of_code_2024/11"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(11, day11.Parse, day11.Part1, day11.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day12 solves day 12 of the advent of code.
+package main
+
+import (
+	day12 "github.com/SpicyHolo/advent_of_code_2024/12"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(12, day12.Parse, day12.Part1, day12.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day13 solves day 13 of the advent of code.
+package main
+
+import (
+	day13 "github.com/SpicyHolo/advent_of_code_2024/13"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(13, day13.Parse, day13.Part1, day13.Part2)
+}
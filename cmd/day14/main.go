@@ -0,0 +1,11 @@
+// Command day14 solves day 14 of the advent of code.
+package main
+
+import (
+	day14 "github.com/SpicyHolo/advent_of_code_2024/14"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(14, day14.Parse, day14.Part1, day14.Part2)
+}
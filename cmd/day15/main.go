@@ -0,0 +1,11 @@
+// Command day15 solves day 15 of the advent of code.
+package main
+
+import (
+	day15 "github.com/SpicyHolo/advent_of_code_2024/15"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(15, day15.Parse, day15.Part1, day15.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day16 solves day 16 of the advent of code.
+package main
+
+import (
+	day16 "github.com/SpicyHolo/advent_of_code_2024/16"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(16, day16.Parse, day16.Part1, day16.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day17 solves day 17 of the advent of code.
+package main
+
+import (
+	day17 "github.com/SpicyHolo/advent_of_code_2024/17"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(17, day17.Parse, day17.Part1, day17.Part2)
+}
@@ -0,0 +1,11 @@
+// Command day18 solves day 18 of the advent of code.
+package main
+
+import (
+	day18 "github.com/SpicyHolo/advent_of_code_2024/18"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(18, day18.Parse, day18.Part1, day18.Part2)
+}
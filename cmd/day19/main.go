@@ -0,0 +1,11 @@
+// Command day19 solves day 19 of the advent of code.
+package main
+
+import (
+	day19 "github.com/SpicyHolo/advent_of_code_2024/19"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(19, day19.Parse, day19.Part1, day19.Part2)
+}
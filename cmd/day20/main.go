@@ -0,0 +1,11 @@
+// Command day20 solves day 20 of the advent of code.
+package main
+
+import (
+	day20 "github.com/SpicyHolo/advent_of_code_2024/20"
+	"github.com/SpicyHolo/advent_of_code_2024/internal/cli"
+)
+
+func main() {
+	cli.Main(20, day20.Parse, day20.Part1, day20.Part2)
+}
@@ -0,0 +1,28 @@
+// Package days links the solver of every day into the solver registry.
+// Import it for its side effects:
+//
+//	import _ "github.com/SpicyHolo/advent_of_code_2024/days"
+package days
+
+import (
+	_ "github.com/SpicyHolo/advent_of_code_2024/01"
+	_ "github.com/SpicyHolo/advent_of_code_2024/02"
+	_ "github.com/SpicyHolo/advent_of_code_2024/03"
+	_ "github.com/SpicyHolo/advent_of_code_2024/04"
+	_ "github.com/SpicyHolo/advent_of_code_2024/05"
+	_ "github.com/SpicyHolo/advent_of_code_2024/06"
+	_ "github.com/SpicyHolo/advent_of_code_2024/07"
+	_ "github.com/SpicyHolo/advent_of_code_2024/08"
+	_ "github.com/SpicyHolo/advent_of_code_2024/09"
+	_ "github.com/SpicyHolo/advent_of_code_2024/10"
+	_ "github.com/SpicyHolo/advent_of_code_2024/11"
+	_ "github.com/SpicyHolo/advent_of_code_2024/12"
+	_ "github.com/SpicyHolo/advent_of_code_2024/13"
+	_ "github.com/SpicyHolo/advent_of_code_2024/14"
+	_ "github.com/SpicyHolo/advent_of_code_2024/15"
+	_ "github.com/SpicyHolo/advent_of_code_2024/16"
+	_ "github.com/SpicyHolo/advent_of_code_2024/17"
+	_ "github.com/SpicyHolo/advent_of_code_2024/18"
+	_ "github.com/SpicyHolo/advent_of_code_2024/19"
+	_ "github.com/SpicyHolo/advent_of_code_2024/20"
+)
@@ -0,0 +1,197 @@
+// Package fetch downloads puzzle inputs from the advent of code website, and caches them on disk.
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultBaseURL = "https://adventofcode.com"
+	DefaultYear    = 2024
+
+	// SessionEnv is the environment variable holding the session cookie.
+	SessionEnv = "AOC_SESSION"
+
+	// Identifies the tool to the website, as its maintainers ask automated tools to do
+	userAgent = "github.com/SpicyHolo/advent_of_code_2024/fetch"
+)
+
+var ErrNoSession = errors.New("no session cookie, set " + SessionEnv + " or provide a session file")
+
+// Client downloads puzzle inputs. Inputs are stored in CacheDir/<day>/input.txt,
+// the same place the runner looks for them, and never downloaded twice.
+// Inputs of other years than DefaultYear are stored in CacheDir/<year>/<day>/input.txt instead.
+type Client struct {
+	BaseURL  string
+	Year     int
+	Session  string
+	CacheDir string
+
+	// MinInterval is the shortest time between two requests to the website.
+	MinInterval time.Duration
+
+	HTTPClient *http.Client
+
+	mu   sync.Mutex
+	last time.Time
+}
+
+// NewClient creates a client for this year's puzzles, that sends at most one request every 5 seconds.
+func NewClient(session, cacheDir string) *Client {
+	return &Client{
+		BaseURL:     DefaultBaseURL,
+		Year:        DefaultYear,
+		Session:     session,
+		CacheDir:    cacheDir,
+		MinInterval: 5 * time.Second,
+		HTTPClient:  &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// CachePath returns where the input of a day is stored.
+func (c *Client) CachePath(day int) string {
+	dir := c.CacheDir
+	if c.Year != DefaultYear {
+		// Keeps the inputs of other years apart from this year's, used by the runner
+		dir = filepath.Join(dir, strconv.Itoa(c.Year))
+	}
+	return filepath.Join(dir, fmt.Sprintf("%02d", day), "input.txt")
+}
+
+// Input returns the puzzle input of a day, from the cache if it's there, or downloaded otherwise.
+// The second result reports whether the input came from the cache.
+func (c *Client) Input(ctx context.Context, day int) ([]byte, bool, error) {
+	if day < 1 || day > 25 {
+		return nil, false, fmt.Errorf("invalid day %d, should be 1-25", day)
+	}
+
+	path := c.CachePath(day)
+	data, err := os.ReadFile(path)
+	if err == nil {
+		return data, true, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, false, fmt.Errorf("could not read cached input: %w", err)
+	}
+
+	data, err = c.download(ctx, day)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := writeFile(path, data); err != nil {
+		return nil, false, fmt.Errorf("could not cache input: %w", err)
+	}
+	return data, false, nil
+}
+
+func (c *Client) download(ctx context.Context, day int) ([]byte, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	url := fmt.Sprintf("%s/%d/day/%d/input", strings.TrimSuffix(c.BaseURL, "/"), c.Year, day)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	req.Header.Set("User-Agent", userAgent)
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not download input: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("could not download input: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("downloading day %d failed: %s: %s", day, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// wait blocks until MinInterval has passed since the previous request
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if delay := time.Until(c.last.Add(c.MinInterval)); delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	c.last = time.Now()
+	return nil
+}
+
+// Writes to a temporary file first, so an interrupted download never leaves a truncated input behind
+func writeFile(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".input-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Session reads the session cookie from the SessionEnv variable, or else from the file at path.
+func Session(path string) (string, error) {
+	if session := strings.TrimSpace(os.Getenv(SessionEnv)); session != "" {
+		return session, nil
+	}
+	if path == "" {
+		return "", ErrNoSession
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoSession
+	}
+	if err != nil {
+		return "", fmt.Errorf("could not read session file: %w", err)
+	}
+
+	session := strings.TrimSpace(string(data))
+	if session == "" {
+		return "", ErrNoSession
+	}
+	return session, nil
+}
@@ -0,0 +1,193 @@
+package fetch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// Stands in for the website, answering every unlocked day with a fake input
+func newServer(t *testing.T, requests *atomic.Int32) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /{year}/day/{n}/input", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		cookie, err := r.Cookie("session")
+		if err != nil || cookie.Value != "secret" {
+			http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+			return
+		}
+		if r.UserAgent() != userAgent {
+			t.Errorf("User-Agent = %q, want %q", r.UserAgent(), userAgent)
+		}
+		if r.PathValue("n") == "25" {
+			http.NotFound(w, r)
+			return
+		}
+		if year := r.PathValue("year"); year != "2024" {
+			fmt.Fprintf(w, "%s ", year)
+		}
+		fmt.Fprintf(w, "input of day %s\n", r.PathValue("n"))
+	})
+
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func newClient(t *testing.T, srv *httptest.Server, session string) *Client {
+	c := NewClient(session, t.TempDir())
+	c.BaseURL = srv.URL
+	c.HTTPClient = srv.Client()
+	c.MinInterval = 0
+	return c
+}
+
+func TestInputIsCached(t *testing.T) {
+	var requests atomic.Int32
+	c := newClient(t, newServer(t, &requests), "secret")
+
+	for i, wantCached := range []bool{false, true} {
+		data, cached, err := c.Input(context.Background(), 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "input of day 7\n" {
+			t.Errorf("input = %q", data)
+		}
+		if cached != wantCached {
+			t.Errorf("call %d: cached = %v, want %v", i+1, cached, wantCached)
+		}
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("server got %d requests, want 1", n)
+	}
+	if _, err := os.Stat(filepath.Join(c.CacheDir, "07", "input.txt")); err != nil {
+		t.Errorf("input not cached: %v", err)
+	}
+}
+
+func TestYearsAreCachedApart(t *testing.T) {
+	var requests atomic.Int32
+	srv := newServer(t, &requests)
+	this := newClient(t, srv, "secret")
+	last := newClient(t, srv, "secret")
+	last.CacheDir, last.Year = this.CacheDir, 2023
+
+	for _, tt := range []struct {
+		c    *Client
+		want string
+	}{
+		{this, "input of day 1\n"},
+		{last, "2023 input of day 1\n"},
+		{this, "input of day 1\n"},
+		{last, "2023 input of day 1\n"},
+	} {
+		data, _, err := tt.c.Input(context.Background(), 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != tt.want {
+			t.Errorf("year %d: input = %q, want %q", tt.c.Year, data, tt.want)
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("server got %d requests, want one per year", n)
+	}
+	if want := filepath.Join(this.CacheDir, "2023", "01", "input.txt"); last.CachePath(1) != want {
+		t.Errorf("CachePath(1) = %q, want %q", last.CachePath(1), want)
+	}
+}
+
+func TestErrorsAreNotCached(t *testing.T) {
+	var requests atomic.Int32
+	srv := newServer(t, &requests)
+
+	tests := []struct {
+		name    string
+		session string
+		day     int
+		want    string
+	}{
+		{"wrong session", "wrong", 1, "400 Bad Request: Puzzle inputs differ by user"},
+		{"locked day", "secret", 25, "404 Not Found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newClient(t, srv, tt.session)
+			_, _, err := c.Input(context.Background(), tt.day)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Fatalf("error = %v, want it to mention %q", err, tt.want)
+			}
+			if _, err := os.Stat(c.CachePath(tt.day)); !errors.Is(err, os.ErrNotExist) {
+				t.Errorf("failed download was cached: %v", err)
+			}
+		})
+	}
+}
+
+func TestNoSession(t *testing.T) {
+	var requests atomic.Int32
+	c := newClient(t, newServer(t, &requests), "")
+
+	if _, _, err := c.Input(context.Background(), 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("error = %v, want %v", err, ErrNoSession)
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("server got %d requests without a session", n)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	var requests atomic.Int32
+	c := newClient(t, newServer(t, &requests), "secret")
+	c.MinInterval = 100 * time.Millisecond
+
+	start := time.Now()
+	for day := 1; day <= 3; day++ {
+		if _, _, err := c.Input(context.Background(), day); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 2*c.MinInterval {
+		t.Errorf("3 downloads took %v, want at least %v", elapsed, 2*c.MinInterval)
+	}
+
+	// Waiting for the next slot gives up with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := c.Input(ctx, 4); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestSession(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session")
+	if err := os.WriteFile(path, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv(SessionEnv, "")
+	if got, err := Session(path); err != nil || got != "from-file" {
+		t.Errorf("Session() = %q, %v, want the file's session", got, err)
+	}
+	if _, err := Session(filepath.Join(t.TempDir(), "missing")); !errors.Is(err, ErrNoSession) {
+		t.Errorf("error = %v, want %v", err, ErrNoSession)
+	}
+
+	t.Setenv(SessionEnv, "from-env")
+	if got, err := Session(path); err != nil || got != "from-env" {
+		t.Errorf("Session() = %q, %v, the environment should win", got, err)
+	}
+}
@@ -0,0 +1,76 @@
+// Package gen generates random, valid puzzle inputs, for testing the solvers at any size.
+// Inputs only depend on the seed and the size, so a failing input can always be generated again.
+package gen
+
+import (
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"slices"
+)
+
+// Day describes the generator of a day's input.
+type Day struct {
+	Size     int    // default size, about the size of the real inputs
+	Unit     string // what the size counts
+	Generate func(r *rand.Rand, size int) string
+}
+
+var days = map[int]Day{
+	1:  {1000, "lines", LocationLists},
+	2:  {1000, "reports", Reports},
+	3:  {700, "instructions", Memory},
+	4:  {140, "letters per side", WordSearch},
+	5:  {200, "updates", PrintQueue},
+	6:  {130, "cells per side", Lab},
+	7:  {850, "equations", Calibrations},
+	8:  {50, "cells per side", City},
+	9:  {19999, "digits", DiskMap},
+	10: {50, "cells per side", func(r *rand.Rand, size int) string { return TopographicMap(r, size, size*size/12) }},
+	11: {8, "stones", Stones},
+	12: {140, "cells per side", Garden},
+	13: {320, "claw machines", ClawMachines},
+	14: {500, "robots", Robots},
+	15: {50, "cells per side", Warehouse},
+	16: {141, "cells per side", ReindeerMaze},
+	17: {16, "outputs", Program},
+	18: {3450, "bytes", FallingBytes},
+	19: {400, "designs", Towels},
+	20: {141, "cells per side", Racetrack},
+}
+
+// Days lists the days with a generator.
+func Days() []int {
+	return slices.Sorted(maps.Keys(days))
+}
+
+// Get returns the generator of a day.
+func Get(day int) (Day, bool) {
+	d, ok := days[day]
+	return d, ok
+}
+
+// New returns the random source for a seed. Its sequence is the same on every platform and Go version.
+func New(seed uint64) *rand.Rand {
+	return rand.New(rand.NewPCG(seed, seed))
+}
+
+// Generate creates an input for a day. A size of 0 uses the day's default size.
+func Generate(day int, seed uint64, size int) (string, error) {
+	d, ok := days[day]
+	if !ok {
+		return "", fmt.Errorf("no generator for day %d, available days: %v", day, Days())
+	}
+	if size < 0 {
+		return "", fmt.Errorf("invalid size %d", size)
+	}
+	if size == 0 {
+		size = d.Size
+	}
+	return d.Generate(New(seed), size), nil
+}
+
+// Random integer in [lo, hi]
+func between(r *rand.Rand, lo, hi int) int {
+	return lo + r.IntN(hi-lo+1)
+}
@@ -0,0 +1,57 @@
+package gen_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	_ "github.com/SpicyHolo/advent_of_code_2024/days"
+	"github.com/SpicyHolo/advent_of_code_2024/gen"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+func TestDeterministic(t *testing.T) {
+	for _, day := range gen.Days() {
+		a, err := gen.Generate(day, 7, 20)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, _ := gen.Generate(day, 7, 20)
+		c, _ := gen.Generate(day, 8, 20)
+		if a != b {
+			t.Errorf("day %d: the same seed gave different inputs", day)
+		}
+		if a == c {
+			t.Errorf("day %d: different seeds gave the same input", day)
+		}
+	}
+}
+
+// Every generated input is solved without errors, except for parts that need an input made for them
+func TestSolvable(t *testing.T) {
+	mayFail := map[[2]int]bool{
+		{17, 2}: true, // only special programs output themselves
+		{18, 2}: true, // the bytes don't always cut off the exit
+	}
+	sizes := map[int]int{9: 2000, 14: 50, 18: 1500}
+
+	for _, day := range gen.Days() {
+		size := sizes[day]
+		if size == 0 {
+			size = 15
+		}
+		data, err := gen.Generate(day, 1, size)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for part := 1; part <= 2; part++ {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			res := solver.Run(ctx, day, part, []byte(data))
+			cancel()
+			if res.Err != nil && !mayFail[[2]int{day, part}] {
+				t.Errorf("%v\ninput:\n%s", res, data)
+			}
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package gen
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+)
+
+// Square grid of bytes, filled with fill
+func square(size int, fill byte) [][]byte {
+	rows := make([][]byte, size)
+	for y := range rows {
+		rows[y] = []byte(strings.Repeat(string(fill), size))
+	}
+	return rows
+}
+
+// Random cells of a square grid, without repeats
+func cells(r *rand.Rand, size, n int) []grid.Point {
+	n = min(n, size*size)
+	points := make([]grid.Point, n)
+	for i, idx := range r.Perm(size * size)[:n] {
+		points[i] = grid.Point{X: idx % size, Y: idx / size}
+	}
+	return points
+}
+
+func render(rows [][]byte) string {
+	var b strings.Builder
+	for _, row := range rows {
+		b.Write(row)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// WordSearch creates a size x size grid of the letters X, M, A and S.
+func WordSearch(r *rand.Rand, size int) string {
+	rows := square(size, '.')
+	for _, row := range rows {
+		for x := range row {
+			row[x] = "XMAS"[r.IntN(4)]
+		}
+	}
+	return render(rows)
+}
+
+// Lab creates a size x size map with a few obstructions, and a guard that walks off the map.
+func Lab(r *rand.Rand, size int) string {
+	size = max(size, 2)
+	for {
+		rows := square(size, '.')
+		for _, p := range cells(r, size, size*size/30) {
+			rows[p.Y][p.X] = '#'
+		}
+		start := cells(r, size, 1)[0]
+		rows[start.Y][start.X] = '^'
+
+		if leavesMap(rows, start) {
+			return render(rows)
+		}
+	}
+}
+
+// Walks the guard like the puzzle, reports false if it gets stuck in a loop
+func leavesMap(rows [][]byte, pos grid.Point) bool {
+	type state struct {
+		pos grid.Point
+		dir geom.Dir
+	}
+	seen := make(map[state]bool)
+
+	dir := geom.Up
+	for !seen[state{pos, dir}] {
+		seen[state{pos, dir}] = true
+
+		next := pos.Add(dir.Vec())
+		switch {
+		case next.X < 0 || next.Y < 0 || next.X >= len(rows) || next.Y >= len(rows):
+			return true
+		case rows[next.Y][next.X] == '#':
+			dir = dir.CW()
+		default:
+			pos = next
+		}
+	}
+	return false
+}
+
+// City creates a size x size map, with antennas on about one cell in twelve.
+func City(r *rand.Rand, size int) string {
+	const frequencies = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+	rows := square(size, '.')
+	for _, p := range cells(r, size, size*size/12) {
+		rows[p.Y][p.X] = frequencies[r.IntN(len(frequencies))]
+	}
+	return render(rows)
+}
+
+// TopographicMap creates a size x size height map with the given number of trailheads.
+// A trail climbs from every trailhead, the rest of the heights are random.
+func TopographicMap(r *rand.Rand, size, trailheads int) string {
+	rows := square(size, '.')
+	for _, row := range rows {
+		for x := range row {
+			row[x] = byte('1' + r.IntN(9))
+		}
+	}
+
+	heads := cells(r, size, trailheads)
+	for _, p := range heads {
+		rows[p.Y][p.X] = '0'
+	}
+
+	// Climb one step at a time, without covering a trailhead or going back on the trail
+	for _, pos := range heads {
+		trail := map[grid.Point]bool{pos: true}
+		for height := byte('1'); height <= '9'; height++ {
+			var next []grid.Point
+			for _, d := range grid.Dirs4 {
+				n := pos.Add(d)
+				if n.X >= 0 && n.Y >= 0 && n.X < size && n.Y < size && rows[n.Y][n.X] != '0' && !trail[n] {
+					next = append(next, n)
+				}
+			}
+			if len(next) == 0 {
+				break
+			}
+			pos = next[r.IntN(len(next))]
+			trail[pos] = true
+			rows[pos.Y][pos.X] = height
+		}
+	}
+	return render(rows)
+}
+
+// Garden creates a size x size map of garden plots. Neighbouring plots often grow the same plant, forming regions.
+func Garden(r *rand.Rand, size int) string {
+	rows := square(size, '.')
+	for y, row := range rows {
+		for x := range row {
+			switch k := r.IntN(10); {
+			case k < 4 && x > 0:
+				row[x] = row[x-1]
+			case k < 7 && y > 0:
+				row[x] = rows[y-1][x]
+			default:
+				row[x] = byte('A' + r.IntN(26))
+			}
+		}
+	}
+	return render(rows)
+}
+
+// Warehouse creates a size x size warehouse with walls and boxes, the robot in the middle,
+// and 8 moves per cell of the warehouse.
+func Warehouse(r *rand.Rand, size int) string {
+	size = max(size, 3)
+	rows := square(size, '#')
+	for y := 1; y < size-1; y++ {
+		for x := 1; x < size-1; x++ {
+			switch k := r.IntN(100); {
+			case k < 8:
+				rows[y][x] = '#'
+			case k < 33:
+				rows[y][x] = 'O'
+			default:
+				rows[y][x] = '.'
+			}
+		}
+	}
+	rows[size/2][size/2] = '@'
+
+	var b strings.Builder
+	b.WriteString(render(rows))
+	b.WriteByte('\n')
+	moves := 8 * size * size
+	for i := range moves {
+		b.WriteByte("^>v<"[r.IntN(4)])
+		if (i+1)%1000 == 0 || i == moves-1 {
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// Perfect maze, every cell with odd coordinates is reachable from every other one in a single way.
+// The size is made odd, so the maze is surrounded by walls.
+func maze(r *rand.Rand, size int) [][]byte {
+	rows := square(size, '#')
+	inside := func(p grid.Point) bool { return p.X > 0 && p.Y > 0 && p.X < size-1 && p.Y < size-1 }
+
+	// Depth first, carving the way to a random unvisited neighbour
+	start := grid.Point{X: 1, Y: 1}
+	rows[start.Y][start.X] = '.'
+	stack := []grid.Point{start}
+	for len(stack) > 0 {
+		cur := stack[len(stack)-1]
+
+		var next []grid.Point
+		for _, d := range grid.Dirs4 {
+			n := cur.Add(d).Add(d)
+			if inside(n) && rows[n.Y][n.X] == '#' {
+				next = append(next, n)
+			}
+		}
+		if len(next) == 0 {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		n := next[r.IntN(len(next))]
+		rows[(cur.Y+n.Y)/2][(cur.X+n.X)/2] = '.'
+		rows[n.Y][n.X] = '.'
+		stack = append(stack, n)
+	}
+	return rows
+}
+
+// ReindeerMaze creates a maze with S in the bottom left corner and E in the top right one.
+// Some walls are knocked down, so there are several ways through.
+func ReindeerMaze(r *rand.Rand, size int) string {
+	size = max(size, 5) | 1
+	rows := maze(r, size)
+
+	for range size * size / 40 {
+		// Walls between two cells have one odd and one even coordinate
+		x, y := between(r, 1, size-2), between(r, 1, size-2)
+		if (x+y)%2 == 1 {
+			rows[y][x] = '.'
+		}
+	}
+
+	rows[size-2][1] = 'S'
+	rows[1][size-2] = 'E'
+	return render(rows)
+}
+
+// Racetrack creates a single track, winding through a size x size map of walls.
+// It's the longest way through a maze, from a random start.
+func Racetrack(r *rand.Rand, size int) string {
+	size = max(size, 5) | 1
+	rows := maze(r, size)
+
+	start := grid.Point{X: 2*r.IntN(size/2) + 1, Y: 2*r.IntN(size/2) + 1}
+
+	// Breadth first from the start, the last cell reached is the furthest one
+	from := map[grid.Point]grid.Point{start: start}
+	queue := []grid.Point{start}
+	var end grid.Point
+	for len(queue) > 0 {
+		end, queue = queue[0], queue[1:]
+		for _, d := range grid.Dirs4 {
+			n := end.Add(d)
+			if _, seen := from[n]; !seen && rows[n.Y][n.X] == '.' {
+				from[n] = end
+				queue = append(queue, n)
+			}
+		}
+	}
+
+	track := square(size, '#')
+	for p := end; p != start; p = from[p] {
+		track[p.Y][p.X] = '.'
+	}
+	track[start.Y][start.X] = 'S'
+	track[end.Y][end.X] = 'E'
+	return render(track)
+}
@@ -0,0 +1,275 @@
+package gen
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// LocationLists creates n lines of two location IDs. About a third of the right IDs also appear on the left.
+func LocationLists(r *rand.Rand, n int) string {
+	left := make([]int, n)
+	for i := range left {
+		left[i] = between(r, 10000, 99999)
+	}
+
+	var b strings.Builder
+	for _, id := range left {
+		right := between(r, 10000, 99999)
+		if r.IntN(3) == 0 {
+			right = left[r.IntN(n)]
+		}
+		fmt.Fprintf(&b, "%d   %d\n", id, right)
+	}
+	return b.String()
+}
+
+// Reports creates n reports of 5 to 8 levels. Most are steady, some have a bad level.
+func Reports(r *rand.Rand, n int) string {
+	var b strings.Builder
+	for range n {
+		levels := make([]string, between(r, 5, 8))
+		level, dir := between(r, 10, 90), 1
+		if r.IntN(2) == 0 {
+			dir = -1
+		}
+		for i := range levels {
+			levels[i] = strconv.Itoa(level)
+
+			step := dir * between(r, 1, 3)
+			if r.IntN(8) == 0 {
+				step = between(r, -5, 5) // a bad level, too big a step or the wrong way
+			}
+			level = min(max(level+step, 1), 99)
+		}
+		b.WriteString(strings.Join(levels, " "))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Memory creates corrupted memory with n instructions: mul(a,b), do(), don't() or corrupted muls, with junk in between.
+func Memory(r *rand.Rand, n int) string {
+	const junk = "!@#$%^&*()[]{}<>?/'+-_ ,:;~who select from where mul why"
+
+	var b strings.Builder
+	for i := range n {
+		for range r.IntN(8) {
+			b.WriteByte(junk[r.IntN(len(junk))])
+		}
+
+		switch k := r.IntN(10); {
+		case k < 6:
+			fmt.Fprintf(&b, "mul(%d,%d)", between(r, 1, 999), between(r, 1, 999))
+		case k == 6:
+			b.WriteString("do()")
+		case k == 7:
+			b.WriteString("don't()")
+		case k == 8:
+			fmt.Fprintf(&b, "mul(%d, %d)", between(r, 1, 999), between(r, 1, 999))
+		default:
+			fmt.Fprintf(&b, "mul[%d,%d)", between(r, 1, 999), between(r, 1, 999))
+		}
+
+		if (i+1)%120 == 0 {
+			b.WriteByte('\n')
+		}
+	}
+	b.WriteByte('\n')
+	return b.String()
+}
+
+// PrintQueue creates the page ordering rules, and n updates of 5 to 23 pages, about half of them in order.
+// The rules cover every pair of pages, so every update has a single correct order.
+func PrintQueue(r *rand.Rand, n int) string {
+	// Pages 10-99 in a random order, the rules follow it
+	pages := r.Perm(90)
+	for i := range pages {
+		pages[i] += 10
+	}
+	pages = pages[:49]
+
+	var b strings.Builder
+	for i, before := range pages {
+		for _, after := range pages[i+1:] {
+			fmt.Fprintf(&b, "%d|%d\n", before, after)
+		}
+	}
+	b.WriteByte('\n')
+
+	for range n {
+		// Taking pages in order of their index keeps the update ordered
+		length := 2*between(r, 2, 11) + 1
+		picked := r.Perm(len(pages))[:length]
+		if r.IntN(2) == 0 {
+			slices.Sort(picked)
+		}
+
+		update := make([]string, length)
+		for i, idx := range picked {
+			update[i] = strconv.Itoa(pages[idx])
+		}
+		b.WriteString(strings.Join(update, ","))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// Calibrations creates n equations of 2 to 8 numbers. About half of them can be made true with +, * and ||.
+func Calibrations(r *rand.Rand, n int) string {
+	var b strings.Builder
+	for range n {
+		operands := make([]string, between(r, 2, 8))
+		result := 0
+		for i := range operands {
+			num := between(r, 1, 99)
+			operands[i] = strconv.Itoa(num)
+
+			switch op := r.IntN(3); {
+			case i == 0:
+				result = num
+			case op == 0:
+				result += num
+			case op == 1:
+				result *= num
+			default:
+				result, _ = strconv.Atoi(strconv.Itoa(result) + operands[i])
+			}
+		}
+		if r.IntN(2) == 0 {
+			result += between(r, 1, 100)
+		}
+		fmt.Fprintf(&b, "%d: %s\n", result, strings.Join(operands, " "))
+	}
+	return b.String()
+}
+
+// DiskMap creates a disk map of n digits. Files take 1 to 9 blocks, and the gaps 0 to 9.
+func DiskMap(r *rand.Rand, n int) string {
+	digits := make([]byte, n)
+	for i := range digits {
+		if i%2 == 0 {
+			digits[i] = byte('1' + r.IntN(9))
+		} else {
+			digits[i] = byte('0' + r.IntN(10))
+		}
+	}
+	return string(digits) + "\n"
+}
+
+// Stones creates a line of n stones, with numbers up to 7 digits.
+func Stones(r *rand.Rand, n int) string {
+	stones := make([]string, n)
+	for i := range stones {
+		stones[i] = strconv.Itoa(r.IntN(10_000_000))
+	}
+	return strings.Join(stones, " ") + "\n"
+}
+
+// ClawMachines creates n claw machines. About half of the prizes can be won, with up to 100 presses of each button.
+func ClawMachines(r *rand.Rand, n int) string {
+	var b strings.Builder
+	for i := range n {
+		var ax, ay, bx, by int
+		// Buttons moving the same way would have many ways to win
+		for ax*by == ay*bx {
+			ax, ay, bx, by = between(r, 10, 99), between(r, 10, 99), between(r, 10, 99), between(r, 10, 99)
+		}
+
+		px, py := between(r, 1000, 20000), between(r, 1000, 20000)
+		if r.IntN(2) == 0 {
+			pressA, pressB := r.IntN(101), r.IntN(101)
+			px, py = pressA*ax+pressB*bx, pressA*ay+pressB*by
+		}
+
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		fmt.Fprintf(&b, "Button A: X+%d, Y+%d\nButton B: X+%d, Y+%d\nPrize: X=%d, Y=%d\n", ax, ay, bx, by, px, py)
+	}
+	return b.String()
+}
+
+// Robots creates n robots on the 101x103 floor of the bathroom.
+func Robots(r *rand.Rand, n int) string {
+	var b strings.Builder
+	for range n {
+		fmt.Fprintf(&b, "p=%d,%d v=%d,%d\n", r.IntN(101), r.IntN(103), between(r, -100, 100), between(r, -100, 100))
+	}
+	return b.String()
+}
+
+// Program creates a program of the same shape as the real ones, printing size values.
+// Every loop it outputs a value depending on the low bits of A, and shifts A right by 3 bits.
+func Program(r *rand.Rand, size int) string {
+	size = min(max(size, 1), 20)
+	a := 1<<(3*(size-1)) + r.IntN(7<<(3*(size-1)))
+
+	return fmt.Sprintf("Register A: %d\nRegister B: 0\nRegister C: 0\n\nProgram: 2,4,1,%d,7,5,1,%d,4,%d,5,5,0,3,3,0\n",
+		a, r.IntN(8), r.IntN(8), r.IntN(8))
+}
+
+// FallingBytes creates n bytes falling on the 71x71 memory space, never on the start or the exit.
+func FallingBytes(r *rand.Rand, n int) string {
+	const side = 71
+
+	// Every position but the first and the last one, in a random order
+	order := r.Perm(side*side - 2)
+	n = min(n, len(order))
+
+	var b strings.Builder
+	for _, i := range order[:n] {
+		i++
+		fmt.Fprintf(&b, "%d,%d\n", i%side, i/side)
+	}
+	return b.String()
+}
+
+// Towels creates the available towel patterns, and n designs. About half of the designs can be made.
+// No pattern ends with g or holds gb, so designs with gb in them can't be made.
+// The designs that can't be made have gb within the first stripes: trying every way to make a long prefix
+// first takes exponential time without memoization.
+func Towels(r *rand.Rand, n int) string {
+	const colours = "wubrg"
+	randomStripes := func(length int) string {
+		s := make([]byte, length)
+		for i := range s {
+			s[i] = colours[r.IntN(len(colours))]
+		}
+		return string(s)
+	}
+
+	var patterns []string
+	seen := make(map[string]bool)
+	for len(patterns) < 400 {
+		p := randomStripes(between(r, 1, 8))
+		if strings.HasSuffix(p, "g") || strings.Contains(p, "gb") || seen[p] {
+			continue
+		}
+		seen[p] = true
+		patterns = append(patterns, p)
+	}
+
+	var b strings.Builder
+	b.WriteString(strings.Join(patterns, ", "))
+	b.WriteString("\n\n")
+	for range n {
+		length := between(r, 20, 60)
+		if r.IntN(2) == 0 {
+			design := []byte(randomStripes(length))
+			at := r.IntN(8)
+			design[at], design[at+1] = 'g', 'b'
+			b.Write(design)
+		} else {
+			var design strings.Builder
+			for design.Len() < length {
+				design.WriteString(patterns[r.IntN(len(patterns))])
+			}
+			b.WriteString(design.String())
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,61 @@
+package geom
+
+import "fmt"
+
+// Dir is one of the four main directions, numbered clockwise from Up.
+type Dir uint8
+
+const (
+	Up Dir = iota
+	Right
+	Down
+	Left
+)
+
+// Dirs lists the main directions clockwise, starting with Up.
+var Dirs = [4]Dir{Up, Right, Down, Left}
+
+var (
+	units  = [4]Vec{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	arrows = [4]byte{'^', '>', 'v', '<'}
+	names  = [4]string{"Up", "Right", "Down", "Left"}
+)
+
+// ParseDir reads a direction from its arrow, one of ^>v<.
+func ParseDir(c byte) (Dir, bool) {
+	for d, arrow := range arrows {
+		if c == arrow {
+			return Dir(d), true
+		}
+	}
+	return 0, false
+}
+
+// Vec is the unit step in the direction.
+func (d Dir) Vec() Vec {
+	return units[d%4]
+}
+
+func (d Dir) CW() Dir {
+	return (d + 1) % 4
+}
+
+func (d Dir) CCW() Dir {
+	return (d + 3) % 4
+}
+
+func (d Dir) Opposite() Dir {
+	return (d + 2) % 4
+}
+
+// Arrow is the character the direction is drawn with, one of ^>v<.
+func (d Dir) Arrow() byte {
+	return arrows[d%4]
+}
+
+func (d Dir) String() string {
+	if d > Left {
+		return fmt.Sprintf("Dir(%d)", uint8(d))
+	}
+	return names[d]
+}
@@ -0,0 +1,90 @@
+package geom
+
+import "testing"
+
+func TestVec(t *testing.T) {
+	v := Vec{3, -2}
+	if got := v.Add(Vec{1, 1}).Sub(Vec{2, 0}).Scale(2); got != (Vec{4, -2}) {
+		t.Errorf("Add, Sub and Scale = %v, want (4, -2)", got)
+	}
+	if got := v.Neg(); got != (Vec{-3, 2}) {
+		t.Errorf("Neg() = %v", got)
+	}
+	if got := (Vec{-1, 205}).Mod(Vec{101, 103}); got != (Vec{100, 102}) {
+		t.Errorf("Mod() = %v, want (100, 102)", got)
+	}
+	if got := v.String(); got != "(3, -2)" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if d := Manhattan(Vec{1, 5}, Vec{4, 1}); d != 7 {
+		t.Errorf("Manhattan = %d, want 7", d)
+	}
+	if d := Chebyshev(Vec{1, 5}, Vec{4, 1}); d != 4 {
+		t.Errorf("Chebyshev = %d, want 4", d)
+	}
+}
+
+func TestRotate(t *testing.T) {
+	for _, d := range Dirs {
+		if got := d.Vec().RotateCW(); got != d.CW().Vec() {
+			t.Errorf("%v rotated clockwise = %v, want %v", d, got, d.CW().Vec())
+		}
+		if got := d.Vec().RotateCCW(); got != d.CCW().Vec() {
+			t.Errorf("%v rotated counterclockwise = %v, want %v", d, got, d.CCW().Vec())
+		}
+		if got := d.Opposite().Vec(); got != d.Vec().Neg() {
+			t.Errorf("opposite of %v = %v", d, got)
+		}
+	}
+
+	v := Vec{2, 7}
+	if got := v.RotateCW().RotateCW().RotateCW().RotateCW(); got != v {
+		t.Errorf("four turns took %v to %v", v, got)
+	}
+}
+
+func TestDir(t *testing.T) {
+	want := map[byte]Dir{'^': Up, '>': Right, 'v': Down, '<': Left}
+	for c, d := range want {
+		got, ok := ParseDir(c)
+		if !ok || got != d || got.Arrow() != c {
+			t.Errorf("ParseDir(%q) = %v, %v, want %v", c, got, ok, d)
+		}
+	}
+	if _, ok := ParseDir('x'); ok {
+		t.Error("ParseDir accepted 'x'")
+	}
+
+	if Up.CW() != Right || Up.CCW() != Left || Left.CW() != Up || Down.Opposite() != Up {
+		t.Error("turns don't go around the four directions")
+	}
+	if Left.String() != "Left" || Dir(7).String() != "Dir(7)" {
+		t.Errorf("String() = %q, %q", Left.String(), Dir(7).String())
+	}
+}
+
+func TestVec3(t *testing.T) {
+	v := Vec3{1, 2, 3}
+	if got := v.Add(Vec3{1, 1, 1}).Sub(Vec3{0, 0, 4}).Scale(3); got != (Vec3{6, 9, 0}) {
+		t.Errorf("Add, Sub and Scale = %v, want (6, 9, 0)", got)
+	}
+	if got := (Vec3{-1, 4, 9}).Mod(Vec3{3, 3, 3}); got != (Vec3{2, 1, 0}) {
+		t.Errorf("Mod() = %v", got)
+	}
+
+	x, y, z := Vec3{1, 0, 0}, Vec3{0, 1, 0}, Vec3{0, 0, 1}
+	if y.RotateX() != z || z.RotateY() != x || x.RotateZ() != y {
+		t.Error("rotations don't follow the right hand rule")
+	}
+	if got := v.RotateX().RotateX().RotateX().RotateX(); got != v {
+		t.Errorf("four turns took %v to %v", v, got)
+	}
+
+	if d := Manhattan3(v, Vec3{-1, 2, 7}); d != 6 {
+		t.Errorf("Manhattan3 = %d, want 6", d)
+	}
+	if d := Chebyshev3(v, Vec3{-1, 2, 7}); d != 4 {
+		t.Errorf("Chebyshev3 = %d, want 4", d)
+	}
+}
@@ -0,0 +1,66 @@
+// Package geom provides integer vectors and directions, shared by every puzzle moving around a map or a space.
+// Y grows downwards, like the rows of the input, so Up is {0, -1} and clockwise turns go from Up to Right.
+package geom
+
+import "fmt"
+
+// Vec is a 2D vector, or a position: X is the column and Y the row.
+type Vec struct {
+	X, Y int
+}
+
+func (v Vec) Add(w Vec) Vec {
+	return Vec{v.X + w.X, v.Y + w.Y}
+}
+
+func (v Vec) Sub(w Vec) Vec {
+	return Vec{v.X - w.X, v.Y - w.Y}
+}
+
+func (v Vec) Scale(k int) Vec {
+	return Vec{k * v.X, k * v.Y}
+}
+
+func (v Vec) Neg() Vec {
+	return Vec{-v.X, -v.Y}
+}
+
+// Mod wraps every coordinate into [0, size), also for negative ones.
+func (v Vec) Mod(size Vec) Vec {
+	return Vec{mod(v.X, size.X), mod(v.Y, size.Y)}
+}
+
+// RotateCW turns the vector a quarter clockwise, Up becomes Right.
+func (v Vec) RotateCW() Vec {
+	return Vec{-v.Y, v.X}
+}
+
+// RotateCCW turns the vector a quarter counterclockwise, Up becomes Left.
+func (v Vec) RotateCCW() Vec {
+	return Vec{v.Y, -v.X}
+}
+
+func (v Vec) String() string {
+	return fmt.Sprintf("(%d, %d)", v.X, v.Y)
+}
+
+// Manhattan is the distance between a and b when moving along the axes.
+func Manhattan(a, b Vec) int {
+	return Abs(a.X-b.X) + Abs(a.Y-b.Y)
+}
+
+// Chebyshev is the distance between a and b when diagonal moves are allowed too.
+func Chebyshev(a, b Vec) int {
+	return max(Abs(a.X-b.X), Abs(a.Y-b.Y))
+}
+
+func Abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func mod(x, n int) int {
+	return (x%n + n) % n
+}
@@ -0,0 +1,58 @@
+package geom
+
+import "fmt"
+
+// Vec3 is a 3D vector, or a position in space.
+type Vec3 struct {
+	X, Y, Z int
+}
+
+func (v Vec3) Add(w Vec3) Vec3 {
+	return Vec3{v.X + w.X, v.Y + w.Y, v.Z + w.Z}
+}
+
+func (v Vec3) Sub(w Vec3) Vec3 {
+	return Vec3{v.X - w.X, v.Y - w.Y, v.Z - w.Z}
+}
+
+func (v Vec3) Scale(k int) Vec3 {
+	return Vec3{k * v.X, k * v.Y, k * v.Z}
+}
+
+func (v Vec3) Neg() Vec3 {
+	return Vec3{-v.X, -v.Y, -v.Z}
+}
+
+// Mod wraps every coordinate into [0, size), also for negative ones.
+func (v Vec3) Mod(size Vec3) Vec3 {
+	return Vec3{mod(v.X, size.X), mod(v.Y, size.Y), mod(v.Z, size.Z)}
+}
+
+// Quarter turns around each axis, counterclockwise when looking from its positive end towards the origin
+// (the right hand rule): RotateX takes Y to Z, RotateY takes Z to X and RotateZ takes X to Y.
+
+func (v Vec3) RotateX() Vec3 {
+	return Vec3{v.X, -v.Z, v.Y}
+}
+
+func (v Vec3) RotateY() Vec3 {
+	return Vec3{v.Z, v.Y, -v.X}
+}
+
+func (v Vec3) RotateZ() Vec3 {
+	return Vec3{-v.Y, v.X, v.Z}
+}
+
+func (v Vec3) String() string {
+	return fmt.Sprintf("(%d, %d, %d)", v.X, v.Y, v.Z)
+}
+
+// Manhattan3 is the distance between a and b when moving along the axes.
+func Manhattan3(a, b Vec3) int {
+	return Abs(a.X-b.X) + Abs(a.Y-b.Y) + Abs(a.Z-b.Z)
+}
+
+// Chebyshev3 is the distance between a and b when diagonal moves are allowed too.
+func Chebyshev3(a, b Vec3) int {
+	return max(Abs(a.X-b.X), Abs(a.Y-b.Y), Abs(a.Z-b.Z))
+}
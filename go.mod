@@ -0,0 +1,3 @@
+module github.com/SpicyHolo/advent_of_code_2024
+
+go 1.23.3
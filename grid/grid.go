@@ -0,0 +1,252 @@
+// Package grid provides a generic 2D grid, for all the puzzles played on a map.
+package grid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/geom"
+)
+
+// Point is a position on the grid, X is the column and Y the row (growing downwards).
+type Point = geom.Vec
+
+// Unit steps in the four main directions
+var (
+	Up    = geom.Up.Vec()
+	Right = geom.Right.Vec()
+	Down  = geom.Down.Vec()
+	Left  = geom.Left.Vec()
+)
+
+// Dirs4 lists the main directions clockwise, starting with Up.
+var Dirs4 = [4]Point{Up, Right, Down, Left}
+
+// Dirs8 lists the main and diagonal directions clockwise, starting with Up.
+var Dirs8 = [8]Point{
+	Up, {X: 1, Y: -1}, Right, {X: 1, Y: 1}, Down, {X: -1, Y: 1}, Left, {X: -1, Y: -1},
+}
+
+// Grid is a rectangular grid of cells, stored row by row.
+type Grid[T comparable] struct {
+	width, height int
+	cells         []T
+}
+
+// New creates a width x height grid, with every cell set to fill.
+func New[T comparable](width, height int, fill T) *Grid[T] {
+	cells := make([]T, width*height)
+	for i := range cells {
+		cells[i] = fill
+	}
+	return &Grid[T]{width: width, height: height, cells: cells}
+}
+
+// Parse reads a grid from text, one row per line. Every character is converted into a cell with convert.
+// All rows must have the same length, empty lines after the last row are ignored.
+func Parse[T comparable](r io.Reader, convert func(p Point, c rune) (T, error)) (*Grid[T], error) {
+	g := &Grid[T]{}
+
+	scanner := bufio.NewScanner(r)
+	blank := 0 // Empty lines seen after the last row
+	for y := 0; scanner.Scan(); y++ {
+		line := []rune(scanner.Text())
+		if len(line) == 0 {
+			blank++
+			continue
+		}
+		if blank > 0 {
+			return nil, fmt.Errorf("line %d: unexpected empty line inside the grid", y+1-blank)
+		}
+		if g.height == 0 {
+			g.width = len(line)
+		}
+		if len(line) != g.width {
+			return nil, fmt.Errorf("line %d: expected %d characters, but got %d", y+1, g.width, len(line))
+		}
+
+		for x, c := range line {
+			cell, err := convert(Point{X: x, Y: g.height}, c)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", y+1, err)
+			}
+			g.cells = append(g.cells, cell)
+		}
+		g.height++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("could not read grid: %w", err)
+	}
+	return g, nil
+}
+
+// Bytes reads a grid of single byte characters.
+func Bytes(r io.Reader) (*Grid[byte], error) {
+	return Parse(r, func(_ Point, c rune) (byte, error) {
+		if c > 0xff {
+			return 0, fmt.Errorf("%q is not a single byte character", c)
+		}
+		return byte(c), nil
+	})
+}
+
+// Runes reads a grid of characters.
+func Runes(r io.Reader) (*Grid[rune], error) {
+	return Parse(r, func(_ Point, c rune) (rune, error) { return c, nil })
+}
+
+func (g *Grid[T]) Width() int  { return g.width }
+func (g *Grid[T]) Height() int { return g.height }
+
+// In checks if the point lies within the grid.
+func (g *Grid[T]) In(p Point) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < g.width && p.Y < g.height
+}
+
+// Get returns the cell at p, and false if p is out of bounds.
+func (g *Grid[T]) Get(p Point) (T, bool) {
+	if !g.In(p) {
+		var zero T
+		return zero, false
+	}
+	return g.cells[p.Y*g.width+p.X], true
+}
+
+// At returns the cell at p, or the zero value if p is out of bounds.
+func (g *Grid[T]) At(p Point) T {
+	v, _ := g.Get(p)
+	return v
+}
+
+// Set updates the cell at p, returns false if p is out of bounds.
+func (g *Grid[T]) Set(p Point, v T) bool {
+	if !g.In(p) {
+		return false
+	}
+	g.cells[p.Y*g.width+p.X] = v
+	return true
+}
+
+// Clone returns a deep copy of the grid.
+func (g *Grid[T]) Clone() *Grid[T] {
+	return &Grid[T]{
+		width:  g.width,
+		height: g.height,
+		cells:  append([]T(nil), g.cells...),
+	}
+}
+
+// All iterates over every cell, row by row.
+func (g *Grid[T]) All() iter.Seq2[Point, T] {
+	return func(yield func(Point, T) bool) {
+		for i, v := range g.cells {
+			if !yield(Point{X: i % g.width, Y: i / g.width}, v) {
+				return
+			}
+		}
+	}
+}
+
+// neighbours iterates over p moved in each of the directions, skipping points out of bounds
+func (g *Grid[T]) neighbours(p Point, dirs []Point) iter.Seq[Point] {
+	return func(yield func(Point) bool) {
+		for _, dir := range dirs {
+			if n := p.Add(dir); g.In(n) && !yield(n) {
+				return
+			}
+		}
+	}
+}
+
+// Neighbours4 iterates over the points above, right, below and left of p, that are within the grid.
+func (g *Grid[T]) Neighbours4(p Point) iter.Seq[Point] {
+	return g.neighbours(p, Dirs4[:])
+}
+
+// Neighbours8 iterates over all eight points surrounding p, that are within the grid.
+func (g *Grid[T]) Neighbours8(p Point) iter.Seq[Point] {
+	return g.neighbours(p, Dirs8[:])
+}
+
+// Find returns the first position (row by row) holding v.
+func (g *Grid[T]) Find(v T) (Point, bool) {
+	for p, cell := range g.All() {
+		if cell == v {
+			return p, true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every position holding v.
+func (g *Grid[T]) FindAll(v T) []Point {
+	var res []Point
+	for p, cell := range g.All() {
+		if cell == v {
+			res = append(res, p)
+		}
+	}
+	return res
+}
+
+// Region flood fills from start, returns all 4-connected points with the same value as start.
+func (g *Grid[T]) Region(start Point) []Point {
+	value, ok := g.Get(start)
+	if !ok {
+		return nil
+	}
+
+	seen := map[Point]struct{}{start: {}}
+	region := []Point{start}
+	for i := 0; i < len(region); i++ {
+		for n := range g.Neighbours4(region[i]) {
+			if _, visited := seen[n]; visited || g.At(n) != value {
+				continue
+			}
+			seen[n] = struct{}{}
+			region = append(region, n)
+		}
+	}
+	return region
+}
+
+// Render draws the grid row by row, using char for every cell.
+func (g *Grid[T]) Render(char func(p Point, v T) rune) string {
+	var builder strings.Builder
+	for p, v := range g.All() {
+		if p.X == 0 && p.Y > 0 {
+			builder.WriteByte('\n')
+		}
+		builder.WriteRune(char(p, v))
+	}
+	return builder.String()
+}
+
+// String draws bytes and runes as characters, booleans as '#' and '.', and other values with fmt.
+func (g *Grid[T]) String() string {
+	var builder strings.Builder
+	for p, v := range g.All() {
+		if p.X == 0 && p.Y > 0 {
+			builder.WriteByte('\n')
+		}
+		switch c := any(v).(type) {
+		case byte:
+			builder.WriteByte(c)
+		case rune:
+			builder.WriteRune(c)
+		case bool:
+			if c {
+				builder.WriteByte('#')
+			} else {
+				builder.WriteByte('.')
+			}
+		default:
+			fmt.Fprint(&builder, c)
+		}
+	}
+	return builder.String()
+}
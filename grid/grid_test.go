@@ -0,0 +1,80 @@
+package grid
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+const example = "AAB\r\nACB\r\nCCB\r\n\r\n"
+
+func TestParse(t *testing.T) {
+	g, err := Runes(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if g.Width() != 3 || g.Height() != 3 {
+		t.Fatalf("size = %dx%d, want 3x3", g.Width(), g.Height())
+	}
+	if got := g.String(); got != "AAB\nACB\nCCB" {
+		t.Errorf("String() = %q", got)
+	}
+
+	if _, err := Runes(strings.NewReader("AB\nABC\n")); err == nil {
+		t.Error("expected an error for rows of different length")
+	}
+	if _, err := Runes(strings.NewReader("AB\n\nAB\n")); err == nil {
+		t.Error("expected an error for an empty line inside the grid")
+	}
+}
+
+func TestGetSet(t *testing.T) {
+	g := New(2, 2, '.')
+
+	if !g.Set(Point{X: 1, Y: 0}, '#') {
+		t.Error("Set within bounds returned false")
+	}
+	if g.Set(Point{X: 2, Y: 0}, '#') {
+		t.Error("Set out of bounds returned true")
+	}
+	if v, ok := g.Get(Point{X: 1, Y: 0}); !ok || v != '#' {
+		t.Errorf("Get(1, 0) = %q, %v", v, ok)
+	}
+	if _, ok := g.Get(Point{X: -1, Y: 0}); ok {
+		t.Error("Get out of bounds returned true")
+	}
+}
+
+func TestNeighbours(t *testing.T) {
+	g := New(3, 3, 0)
+
+	if got := slices.Collect(g.Neighbours4(Point{X: 0, Y: 0})); !slices.Equal(got, []Point{{X: 1, Y: 0}, {X: 0, Y: 1}}) {
+		t.Errorf("Neighbours4 of a corner = %v", got)
+	}
+	if got := slices.Collect(g.Neighbours8(Point{X: 1, Y: 1})); len(got) != 8 {
+		t.Errorf("Neighbours8 of the center = %v, want 8 points", got)
+	}
+}
+
+func TestFindAndRegion(t *testing.T) {
+	g, err := Runes(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p, ok := g.Find('C'); !ok || p != (Point{X: 1, Y: 1}) {
+		t.Errorf("Find('C') = %v, %v", p, ok)
+	}
+	if got := len(g.FindAll('B')); got != 3 {
+		t.Errorf("FindAll('B') found %d points, want 3", got)
+	}
+
+	// Region only spreads to 4-connected cells
+	if got := len(g.Region(Point{X: 0, Y: 0})); got != 3 {
+		t.Errorf("region of A has %d points, want 3", got)
+	}
+	if got := len(g.Region(Point{X: 1, Y: 1})); got != 3 {
+		t.Errorf("region of C has %d points, want 3", got)
+	}
+}
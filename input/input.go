@@ -0,0 +1,129 @@
+// Package input reads puzzle inputs the same way for every day.
+// CRLF and LF line endings are both accepted, and trailing newlines are ignored.
+package input
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+)
+
+// LineError is a parse error on a line of the input.
+type LineError struct {
+	Line int // counting from 1
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error { return e.Err }
+
+// Text reads the whole input, with line endings turned into "\n", and without trailing newlines.
+func Text(r io.Reader) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("could not read input: %w", err)
+	}
+
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+	return strings.TrimRight(text, "\n"), nil
+}
+
+// Lines reads the input line by line.
+func Lines(r io.Reader) ([]string, error) {
+	text, err := Text(r)
+	if err != nil || text == "" {
+		return nil, err
+	}
+	return strings.Split(text, "\n"), nil
+}
+
+// Parse converts every line with parse. Lines are numbered starting with first, errors are wrapped in a LineError.
+func Parse[T any](lines []string, first int, parse func(line string) (T, error)) ([]T, error) {
+	res := make([]T, len(lines))
+	for i, line := range lines {
+		v, err := parse(line)
+		if err != nil {
+			return nil, &LineError{Line: first + i, Err: err}
+		}
+		res[i] = v
+	}
+	return res, nil
+}
+
+// ParseLines reads the input, and converts every line with parse.
+func ParseLines[T any](r io.Reader, parse func(line string) (T, error)) ([]T, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	return Parse(lines, 1, parse)
+}
+
+// Paragraph is a block of lines, separated from the rest of the input by empty lines.
+type Paragraph struct {
+	Line  int // number of the first line in the input
+	Lines []string
+}
+
+// Text joins the lines of the paragraph.
+func (p Paragraph) Text() string {
+	return strings.Join(p.Lines, "\n")
+}
+
+// Paragraphs reads the input, split on empty lines. Several empty lines in a row count as one.
+func Paragraphs(r io.Reader) ([]Paragraph, error) {
+	lines, err := Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Paragraph
+	for i, line := range lines {
+		switch {
+		case line == "":
+			continue
+		case i == 0 || lines[i-1] == "":
+			res = append(res, Paragraph{Line: i + 1})
+		}
+		p := &res[len(res)-1]
+		p.Lines = append(p.Lines, line)
+	}
+	return res, nil
+}
+
+// Grid reads a grid of single byte characters, one row per line.
+func Grid(r io.Reader) (*grid.Grid[byte], error) {
+	return grid.Bytes(r)
+}
+
+var intPattern = regexp.MustCompile(`-?\d+`)
+
+// Ints returns every integer in s, in order. Anything between them is ignored, such as "p=" in "p=0,4".
+func Ints(s string) ([]int, error) {
+	matches := intPattern.FindAllString(s, -1)
+	res := make([]int, len(matches))
+	for i, match := range matches {
+		n, err := strconv.Atoi(match)
+		if err != nil {
+			return nil, err
+		}
+		res[i] = n
+	}
+	return res, nil
+}
+
+// Fields splits s around any of the characters in seps, and drops empty fields.
+// Without seps, s is split around whitespace like strings.Fields.
+func Fields(s, seps string) []string {
+	if seps == "" {
+		return strings.Fields(s)
+	}
+	return strings.FieldsFunc(s, func(c rune) bool { return strings.ContainsRune(seps, c) })
+}
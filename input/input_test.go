@@ -0,0 +1,116 @@
+package input
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name, input string
+		want        []string
+	}{
+		{"LF", "a\nb\n", []string{"a", "b"}},
+		{"CRLF", "a\r\nb\r\n", []string{"a", "b"}},
+		{"no trailing newline", "a\nb", []string{"a", "b"}},
+		{"trailing empty lines", "a\n\n\r\n", []string{"a"}},
+		{"empty line inside", "a\r\n\r\nb", []string{"a", "", "b"}},
+		{"empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Lines(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Lines() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParagraphs(t *testing.T) {
+	for _, newline := range []string{"\n", "\r\n"} {
+		text := strings.ReplaceAll("r, wr\n\nbrwrr\nbggr\n\n\ngbbr\n", "\n", newline)
+		got, err := Paragraphs(strings.NewReader(text))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		want := []Paragraph{
+			{Line: 1, Lines: []string{"r, wr"}},
+			{Line: 3, Lines: []string{"brwrr", "bggr"}},
+			{Line: 7, Lines: []string{"gbbr"}},
+		}
+		if len(got) != len(want) {
+			t.Fatalf("%q: got %d paragraphs, want %d", newline, len(got), len(want))
+		}
+		for i := range want {
+			if got[i].Line != want[i].Line || !slices.Equal(got[i].Lines, want[i].Lines) {
+				t.Errorf("%q: paragraph %d = %+v, want %+v", newline, i, got[i], want[i])
+			}
+		}
+	}
+}
+
+func TestParseLines(t *testing.T) {
+	got, err := ParseLines(strings.NewReader("1\r\n2\r\n"), strconv.Atoi)
+	if err != nil || !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("ParseLines() = %v, %v", got, err)
+	}
+
+	_, err = ParseLines(strings.NewReader("1\n2\nx\n"), strconv.Atoi)
+	var lineErr *LineError
+	if !errors.As(err, &lineErr) || lineErr.Line != 3 {
+		t.Fatalf("error = %v, want an error on line 3", err)
+	}
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("error = %v, should wrap %v", err, strconv.ErrSyntax)
+	}
+}
+
+func TestGrid(t *testing.T) {
+	g, err := Grid(strings.NewReader("#.\r\n.#\r\n\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Width() != 2 || g.Height() != 2 {
+		t.Errorf("grid is %dx%d, want 2x2 without a trailing empty row", g.Width(), g.Height())
+	}
+}
+
+func TestInts(t *testing.T) {
+	got, err := Ints("p=0,4 v=3,-3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 4, 3, -3}; !slices.Equal(got, want) {
+		t.Errorf("Ints() = %v, want %v", got, want)
+	}
+
+	if _, err := Ints("99999999999999999999"); err == nil {
+		t.Error("expected an error for an integer out of range")
+	}
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		s, seps string
+		want    []string
+	}{
+		{"3   4\t5", "", []string{"3", "4", "5"}},
+		{"r, wr, b", ", ", []string{"r", "wr", "b"}},
+		{"190: 10 19", ": ", []string{"190", "10", "19"}},
+	}
+
+	for _, tt := range tests {
+		if got := Fields(tt.s, tt.seps); !slices.Equal(got, tt.want) {
+			t.Errorf("Fields(%q, %q) = %q, want %q", tt.s, tt.seps, got, tt.want)
+		}
+	}
+}
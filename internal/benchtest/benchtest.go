@@ -0,0 +1,57 @@
+// Package benchtest benchmarks the days on full-size generated inputs.
+package benchtest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/gen"
+	"github.com/SpicyHolo/advent_of_code_2024/search"
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Queues benchmarks both parts of a registered day on an input generated at the size of the real ones,
+// once with every priority queue of the search package, to show what the indexed queue saves over the linear one.
+// Parts can change their input, so every run gets it freshly parsed, outside of the timer.
+func Queues(b *testing.B, day int) {
+	s, ok := solver.Get(day)
+	if !ok {
+		b.Fatalf("no solver registered for day %d", day)
+	}
+	input, err := gen.Generate(day, 1, 0)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	queues := []struct {
+		name string
+		kind search.QueueKind
+	}{
+		{"indexed", search.IndexedQueue},
+		{"linear", search.LinearQueue},
+	}
+	for _, q := range queues {
+		for part := 1; part <= 2; part++ {
+			solve := s.Part(part)
+			b.Run(fmt.Sprintf("%s/part%d", q.name, part), func(b *testing.B) {
+				defer func(old search.QueueKind) { search.Queue = old }(search.Queue)
+				search.Queue = q.kind
+
+				for range b.N {
+					b.StopTimer()
+					data, err := s.Parse(bytes.NewReader([]byte(input)))
+					if err != nil {
+						b.Fatal(err)
+					}
+					b.StartTimer()
+
+					if _, err := solve(context.Background(), data); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,198 @@
+// Package cli is the shared main of the cmd/dayNN commands, that call a day's exported API directly.
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Main parses the command line, solves the requested parts of a day, and prints their answers.
+// Commands can define flags of their own on flag.CommandLine before calling Main, and modes replacing the solvers.
+func Main[T any](day int, parse func(r io.Reader) (T, error), part1, part2 func(ctx context.Context, input T) (string, error), modes ...Mode) {
+	mainWith(day, func(ctx context.Context, inputPath string, part int) error {
+		m, err := activeMode(modes)
+		if err != nil {
+			return err
+		}
+		if m != nil {
+			return runMode(ctx, inputPath, part, *m)
+		}
+		return run(ctx, day, inputPath, part, parse, part1, part2)
+	})
+}
+
+// Mode is another use of a day command than solving the parts, such as a report, chosen by a flag of the command.
+// Active is checked once the command line is parsed, Run then gets the input instead of the solvers.
+type Mode struct {
+	Flag   string // flag choosing the mode, for errors
+	Active func() bool
+	Run    func(ctx context.Context, in io.Reader, out io.Writer, part int) error
+}
+
+// Finds the mode chosen on the command line, nil to solve the parts. Modes can't be combined.
+func activeMode(modes []Mode) (*Mode, error) {
+	var active *Mode
+	for i, m := range modes {
+		if !m.Active() {
+			continue
+		}
+		if active != nil {
+			return nil, fmt.Errorf("-%s and -%s can't be used together", active.Flag, m.Flag)
+		}
+		active = &modes[i]
+	}
+	return active, nil
+}
+
+// Streamer solves both parts in a single pass over the input, without reading it all in memory first.
+type Streamer func(ctx context.Context, r io.Reader) (part1, part2 string, err error)
+
+// StreamMode adds a -stream flag, solving both parts with stream.
+func StreamMode(day int, stream Streamer) Mode {
+	useStream := flag.Bool("stream", false, "solve both parts in a single pass over the input, without reading it all in memory")
+	return Mode{
+		Flag:   "stream",
+		Active: func() bool { return *useStream },
+		Run: func(ctx context.Context, in io.Reader, out io.Writer, part int) error {
+			return runStream(ctx, day, in, out, part, stream)
+		},
+	}
+}
+
+func mainWith(day int, solve func(ctx context.Context, inputPath string, part int) error) {
+	inputPath := flag.String("input", fmt.Sprintf("%02d/input.txt", day), "puzzle input file, - for stdin")
+	part := flag.Int("part", 0, "part to solve (1 or 2), both if 0")
+	timeout := flag.Duration("timeout", 0, "stop solving after this long, 0 for no limit (Ctrl-C stops too)")
+	setupLog := LogFlags(flag.CommandLine)
+	startProfile := ProfileFlags(flag.CommandLine)
+	flag.Parse()
+	if err := setupLog(); err != nil {
+		fmt.Fprintf(os.Stderr, "day%02d: %v\n", day, err)
+		os.Exit(2)
+	}
+
+	stopProfile, err := startProfile(os.Stderr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "day%02d: %v\n", day, err)
+		os.Exit(1)
+	}
+
+	ctx, cancel := Context(*timeout)
+	err = solve(ctx, *inputPath, *part)
+	cancel()
+	err = errors.Join(err, stopProfile())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "day%02d: %v\n", day, err)
+		os.Exit(1)
+	}
+}
+
+// Parts returns the parts to solve for a -part flag: part 1 or 2, or both for 0.
+func Parts(part int) ([]int, error) {
+	switch part {
+	case 0:
+		return []int{1, 2}, nil
+	case 1, 2:
+		return []int{part}, nil
+	}
+	return nil, fmt.Errorf("invalid part %d, should be 1 or 2", part)
+}
+
+// Opens the input file, or stdin for -
+func openInput(inputPath string) (io.ReadCloser, error) {
+	if inputPath == "-" {
+		return io.NopCloser(os.Stdin), nil
+	}
+	f, err := os.Open(inputPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read input: %w", err)
+	}
+	return f, nil
+}
+
+func run[T any](ctx context.Context, day int, inputPath string, part int, parse func(io.Reader) (T, error), part1, part2 func(context.Context, T) (string, error)) error {
+	parts, err := Parts(part)
+	if err != nil {
+		return err
+	}
+
+	in, err := openInput(inputPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	// Read it once, every part gets freshly parsed input
+	data, err := io.ReadAll(in)
+	if err != nil {
+		return fmt.Errorf("could not read input: %w", err)
+	}
+
+	for _, p := range parts {
+		solve := part1
+		if p == 2 {
+			solve = part2
+		}
+
+		start := time.Now()
+		input, err := parse(bytes.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("could not parse input: %w", err)
+		}
+		progress := NewProgressLine(os.Stderr, fmt.Sprintf("day %02d part %d", day, p))
+		answer, err := solve(solver.WithProgress(ctx, progress.Report), input)
+		progress.Clear()
+		if err != nil {
+			return fmt.Errorf("part %d: %w", p, err)
+		}
+		fmt.Printf("day %02d part %d: %s (%v)\n", day, p, answer, time.Since(start))
+	}
+	return nil
+}
+
+func runMode(ctx context.Context, inputPath string, part int, m Mode) error {
+	if _, err := Parts(part); err != nil {
+		return err
+	}
+
+	in, err := openInput(inputPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	return m.Run(ctx, in, os.Stdout, part)
+}
+
+// Solves both parts in one pass over the input, and prints the answers of the requested ones with the time of the pass
+func runStream(ctx context.Context, day int, in io.Reader, out io.Writer, part int, stream Streamer) error {
+	parts, err := Parts(part)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	progress := NewProgressLine(os.Stderr, fmt.Sprintf("day %02d", day))
+	answer1, answer2, err := stream(solver.WithProgress(ctx, progress.Report), in)
+	progress.Clear()
+	if err != nil {
+		return err
+	}
+	elapsed := time.Since(start)
+
+	for _, p := range parts {
+		answer := answer1
+		if p == 2 {
+			answer = answer2
+		}
+		fmt.Fprintf(out, "day %02d part %d: %s (%v)\n", day, p, answer, elapsed)
+	}
+	return nil
+}
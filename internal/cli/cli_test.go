@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(path, []byte("abc"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	parse := func(r io.Reader) (string, error) {
+		data, err := io.ReadAll(r)
+		return string(data), err
+	}
+	var got []string
+	solve := func(_ context.Context, s string) (string, error) {
+		got = append(got, s)
+		return strings.ToUpper(s), nil
+	}
+
+	if err := run(context.Background(), 1, path, 0, parse, solve, solve); err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != "abc" || got[1] != "abc" {
+		t.Errorf("parts were given %q, want the input twice", got)
+	}
+
+	if err := run(context.Background(), 1, path, 3, parse, solve, solve); err == nil {
+		t.Error("expected an error for part 3")
+	}
+	if err := run(context.Background(), 1, filepath.Join(t.TempDir(), "missing"), 1, parse, solve, solve); err == nil {
+		t.Error("expected an error for a missing input")
+	}
+}
+
+func TestRunStream(t *testing.T) {
+	calls := 0
+	stream := func(_ context.Context, r io.Reader) (string, string, error) {
+		calls++
+		data, err := io.ReadAll(r)
+		return strings.ToUpper(string(data)), string(data), err
+	}
+
+	var out strings.Builder
+	if err := runStream(context.Background(), 1, strings.NewReader("abc"), &out, 0, stream); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 1 {
+		t.Errorf("stream was called %d times for both parts, want once", calls)
+	}
+	if got := out.String(); !strings.Contains(got, "day 01 part 1: ABC") || !strings.Contains(got, "day 01 part 2: abc") {
+		t.Errorf("output = %q", got)
+	}
+
+	out.Reset()
+	if err := runStream(context.Background(), 1, strings.NewReader("abc"), &out, 2, stream); err != nil || strings.Contains(out.String(), "part 1") {
+		t.Errorf("part 2 only: output %q, error %v", out.String(), err)
+	}
+	if err := runStream(context.Background(), 1, strings.NewReader("abc"), &out, 3, stream); err == nil {
+		t.Error("expected an error for part 3")
+	}
+}
+
+func TestActiveMode(t *testing.T) {
+	mode := func(flag string, active bool) Mode {
+		return Mode{Flag: flag, Active: func() bool { return active }}
+	}
+
+	if m, err := activeMode([]Mode{mode("stream", false), mode("matrix", false)}); m != nil || err != nil {
+		t.Errorf("no flag: got mode %v, error %v, want the solvers", m, err)
+	}
+	if m, err := activeMode([]Mode{mode("stream", false), mode("matrix", true)}); err != nil || m == nil || m.Flag != "matrix" {
+		t.Errorf("-matrix: got mode %v, error %v", m, err)
+	}
+	_, err := activeMode([]Mode{mode("stream", true), mode("matrix", false), mode("report", true)})
+	if err == nil || !strings.Contains(err.Error(), "-stream and -report") {
+		t.Errorf("-stream -report: error %v, want both flags rejected", err)
+	}
+}
+
+func TestLogFlags(t *testing.T) {
+	defer slog.SetDefault(slog.Default())
+
+	tests := []struct {
+		args []string
+		want slog.Level
+	}{
+		{nil, slog.LevelWarn},
+		{[]string{"-v"}, slog.LevelInfo},
+		{[]string{"-v", "-v"}, slog.LevelDebug},
+		{[]string{"-vv", "-log", "json"}, slog.LevelDebug},
+	}
+
+	for _, tt := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		setup := LogFlags(fs)
+		if err := fs.Parse(tt.args); err != nil {
+			t.Fatal(err)
+		}
+		if err := setup(); err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := context.Background()
+		if !slog.Default().Enabled(ctx, tt.want) || slog.Default().Enabled(ctx, tt.want-1) {
+			t.Errorf("%q: logger isn't enabled from level %v", tt.args, tt.want)
+		}
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	setup := LogFlags(fs)
+	fs.Parse([]string{"-log", "xml"})
+	if err := setup(); err == nil {
+		t.Error("expected an error for an unknown log format")
+	}
+}
@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+)
+
+// Counts how often -v is given, -vv counts twice
+type verbosity struct {
+	level *int
+	step  int
+}
+
+func (v verbosity) String() string {
+	if v.level == nil {
+		return "0"
+	}
+	return strconv.Itoa(*v.level)
+}
+
+func (v verbosity) Set(s string) error {
+	on, err := strconv.ParseBool(s)
+	if err != nil {
+		return err
+	}
+	if on {
+		*v.level += v.step
+	}
+	return nil
+}
+
+func (v verbosity) IsBoolFlag() bool { return true }
+
+// LogFlags defines -v, -vv and -log on fs. Once fs is parsed, the returned function
+// makes the logger they describe the default slog logger, writing to stderr.
+// Only warnings are logged by default, -v adds info and -vv debug messages.
+func LogFlags(fs *flag.FlagSet) func() error {
+	level := new(int)
+	fs.Var(verbosity{level, 1}, "v", "log progress information, repeat or use -vv for debug output")
+	fs.Var(verbosity{level, 2}, "vv", "log debug output")
+	format := fs.String("log", "text", "log format, text or json")
+
+	return func() error {
+		opts := &slog.HandlerOptions{Level: slog.LevelWarn}
+		switch {
+		case *level >= 2:
+			opts.Level = slog.LevelDebug
+		case *level == 1:
+			opts.Level = slog.LevelInfo
+		}
+
+		var h slog.Handler
+		switch *format {
+		case "text":
+			h = slog.NewTextHandler(os.Stderr, opts)
+		case "json":
+			h = slog.NewJSONHandler(os.Stderr, opts)
+		default:
+			return fmt.Errorf("unknown log format %q, should be text or json", *format)
+		}
+		slog.SetDefault(slog.New(h))
+		return nil
+	}
+}
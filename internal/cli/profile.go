@@ -0,0 +1,76 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/SpicyHolo/advent_of_code_2024/profile"
+)
+
+// ProfileFlags defines -cpuprofile, -memprofile, -trace and -top on fs. Once fs is parsed, the returned
+// function starts capturing what they ask for. Its stop function writes the files, and prints the top
+// functions of the profiles to w. With -top alone, the CPU profile goes to a temporary file.
+func ProfileFlags(fs *flag.FlagSet) func(w io.Writer) (stop func() error, err error) {
+	cpu := fs.String("cpuprofile", "", "write a CPU profile of the solvers to this file")
+	mem := fs.String("memprofile", "", "write a memory profile of the solvers to this file")
+	trace := fs.String("trace", "", "write an execution trace of the solvers to this file")
+	top := fs.Int("top", 0, "print the top N functions of the profiles to stderr")
+
+	return func(w io.Writer) (func() error, error) {
+		opts := profile.Options{CPU: *cpu, Mem: *mem, Trace: *trace}
+
+		var tmp string
+		if *top > 0 && opts.CPU == "" && opts.Mem == "" {
+			f, err := os.CreateTemp("", "aoc-*.pprof")
+			if err != nil {
+				return nil, fmt.Errorf("could not create CPU profile: %w", err)
+			}
+			f.Close()
+			tmp, opts.CPU = f.Name(), f.Name()
+		}
+
+		s, err := profile.Start(opts)
+		if err != nil {
+			if tmp != "" {
+				os.Remove(tmp)
+			}
+			return nil, err
+		}
+
+		return func() error {
+			if tmp != "" {
+				defer os.Remove(tmp)
+			}
+			if err := s.Stop(); err != nil || *top <= 0 {
+				return err
+			}
+
+			// The solvers allocate most of their memory as they go, in-use memory is about gone by now
+			if opts.CPU != "" {
+				if err := writeTop(w, opts.CPU, "cpu", *top); err != nil {
+					return err
+				}
+			}
+			if opts.Mem != "" {
+				return writeTop(w, opts.Mem, "alloc_space", *top)
+			}
+			return nil
+		}, nil
+	}
+}
+
+func writeTop(w io.Writer, path, sampleType string, n int) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	p, err := profile.Parse(f)
+	if err != nil {
+		return err
+	}
+	return p.WriteTop(w, sampleType, n)
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Context returns the context to solve parts with. It's cancelled on SIGINT, and after timeout unless it's 0.
+func Context(timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	if timeout <= 0 {
+		return ctx, stop
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// ProgressLine shows the progress of a part on a single line, redrawn in place.
+type ProgressLine struct {
+	w     io.Writer
+	label string
+	shown bool
+}
+
+// NewProgressLine creates a progress line on w, starting with label.
+func NewProgressLine(w io.Writer, label string) *ProgressLine {
+	return &ProgressLine{w: w, label: label}
+}
+
+// Report redraws the line with p. It can be passed to solver.WithProgress.
+func (l *ProgressLine) Report(p solver.Progress) {
+	fmt.Fprintf(l.w, "\r%s: %v\x1b[K", l.label, p)
+	l.shown = true
+}
+
+// Clear removes the line once the part is done, if anything was shown.
+func (l *ProgressLine) Clear() {
+	if l.shown {
+		fmt.Fprint(l.w, "\r\x1b[K")
+		l.shown = false
+	}
+}
@@ -0,0 +1,168 @@
+// Package difftest compares two implementations of the same question on generated inputs.
+// An input they disagree on is shrunk to a minimal one, and saved to testdata for debugging.
+package difftest
+
+import (
+	"iter"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// Reducer proposes smaller versions of an input, the first ones being the smallest.
+type Reducer func(input string) iter.Seq[string]
+
+// Shrink reduces an input for which fails is true, until none of the reducers finds a smaller input that still fails.
+// Candidates longer than the input, or already tried, are skipped, so it always ends.
+func Shrink(input string, fails func(input string) bool, reducers ...Reducer) string {
+	tried := map[string]bool{input: true}
+	for shrunk := true; shrunk; {
+		shrunk = false
+		for _, reduce := range reducers {
+			for candidate := range reduce(input) {
+				if len(candidate) > len(input) || tried[candidate] {
+					continue
+				}
+				tried[candidate] = true
+				if fails(candidate) {
+					input, shrunk = candidate, true
+					break
+				}
+			}
+		}
+	}
+	return input
+}
+
+// Check fails the test if check returns an error for input. The input is shrunk with the reducers first,
+// and saved to testdata/shrunk, named after the test.
+// Check should return nil for inputs outside of the puzzle's constraints, which shrinking may produce.
+func Check(t testing.TB, input string, check func(input string) error, reducers ...Reducer) {
+	t.Helper()
+	if err := check(input); err == nil {
+		return
+	}
+
+	input = Shrink(input, func(s string) bool { return check(s) != nil }, reducers...)
+	err := check(input)
+
+	name := strings.NewReplacer("/", "_", "#", "_").Replace(t.Name())
+	path := filepath.Join(dir, name+".txt")
+	if werr := os.MkdirAll(dir, 0o755); werr != nil {
+		t.Fatalf("%v, on input:\n%s\ncould not save it: %v", err, input, werr)
+	}
+	if werr := os.WriteFile(path, []byte(input), 0o644); werr != nil {
+		t.Fatalf("%v, on input:\n%s\ncould not save it: %v", err, input, werr)
+	}
+	t.Fatalf("%v, on the input saved to %s:\n%s", err, path, input)
+}
+
+// Removes runs of parts, halving the length of the runs down to a single part
+func remove(parts []string, join func([]string) string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for size := max(len(parts)/2, 1); size >= 1; size /= 2 {
+			for i := 0; i+size <= len(parts); i += size {
+				if !yield(join(slices.Concat(parts[:i], parts[i+size:]))) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Separated splits an input on sep, and removes runs of the parts.
+func Separated(sep string) Reducer {
+	return func(input string) iter.Seq[string] {
+		text := strings.TrimRight(input, "\n")
+		join := func(parts []string) string { return strings.Join(parts, sep) + "\n" }
+		return remove(strings.Split(text, sep), join)
+	}
+}
+
+var (
+	// Lines removes runs of lines.
+	Lines = Separated("\n")
+	// Paragraphs removes runs of paragraphs, separated by empty lines.
+	Paragraphs = Separated("\n\n")
+)
+
+// Fields removes runs of the fields of every line, separated by sep.
+func Fields(sep string) Reducer {
+	return func(input string) iter.Seq[string] {
+		return func(yield func(string) bool) {
+			lines := strings.Split(input, "\n")
+			for i, line := range lines {
+				join := func(fields []string) string {
+					lines := slices.Clone(lines)
+					lines[i] = strings.Join(fields, sep)
+					return strings.Join(lines, "\n")
+				}
+				for candidate := range remove(strings.Split(line, sep), join) {
+					if !yield(candidate) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// Columns removes runs of columns from a grid.
+func Columns(input string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		rows := strings.Split(strings.TrimRight(input, "\n"), "\n")
+		width := len(rows[0])
+		for size := max(width/2, 1); size >= 1; size /= 2 {
+			for x := 0; x+size <= width; x += size {
+				var b strings.Builder
+				for _, row := range rows {
+					b.WriteString(row[:min(x, len(row))])
+					b.WriteString(row[min(x+size, len(row)):])
+					b.WriteByte('\n')
+				}
+				if !yield(b.String()) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Where Check saves the shrunk inputs
+var dir = filepath.Join("testdata", "shrunk")
+
+var number = regexp.MustCompile(`\d+`)
+
+// Numbers makes every number of the input smaller: 0, 1, half of it, or one less.
+func Numbers(input string) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		for _, loc := range number.FindAllStringIndex(input, -1) {
+			n, err := strconv.Atoi(input[loc[0]:loc[1]])
+			if err != nil {
+				continue
+			}
+			for _, smaller := range []int{0, 1, n / 2, n - 1} {
+				if smaller >= 0 && smaller < n && !yield(input[:loc[0]]+strconv.Itoa(smaller)+input[loc[1]:]) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Replace replaces the byte from with to, one at a time.
+func Replace(from, to byte) Reducer {
+	return func(input string) iter.Seq[string] {
+		return func(yield func(string) bool) {
+			for i := range len(input) {
+				if input[i] == from && !yield(input[:i]+string(to)+input[i+1:]) {
+					return
+				}
+			}
+		}
+	}
+}
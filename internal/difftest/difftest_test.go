@@ -0,0 +1,104 @@
+package difftest
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestShrink(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		fails    func(string) bool
+		reducers []Reducer
+		want     string
+	}{
+		{
+			name:     "lines",
+			input:    "1\n2\n3\n4\n5\n6\n7\n8\n",
+			fails:    func(s string) bool { return strings.Contains(s, "3") && strings.Contains(s, "6") },
+			reducers: []Reducer{Lines},
+			want:     "3\n6\n",
+		},
+		{
+			name:  "fields and numbers",
+			input: "17, 4, 250, 9\n",
+			fails: func(s string) bool {
+				for _, f := range strings.Split(strings.TrimSpace(s), ", ") {
+					if n, _ := strconv.Atoi(f); n >= 100 {
+						return true
+					}
+				}
+				return false
+			},
+			reducers: []Reducer{Fields(", "), Numbers},
+			want:     "100\n",
+		},
+		{
+			name:     "grid",
+			input:    "#####\n#.#.#\n#..E#\n#####\n",
+			fails:    func(s string) bool { return strings.Contains(s, ".E") },
+			reducers: []Reducer{Lines, Columns, Replace('.', '#')},
+			want:     ".E\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Shrink(tt.input, tt.fails, tt.reducers...); got != tt.want {
+				t.Errorf("Shrink() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	dir = t.TempDir()
+
+	check := func(s string) error {
+		if strings.Contains(s, "b") {
+			return errors.New("found b")
+		}
+		return nil
+	}
+	Check(t, "a\nc\n", check, Lines)
+
+	// A failing check stops the test, run it on the side
+	ft := &fakeT{TB: t}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Check(ft, "a\nb\nc\n", check, Lines)
+	}()
+	<-done
+
+	if !strings.Contains(ft.msg, "found b") {
+		t.Errorf("message = %q, want the error of the check", ft.msg)
+	}
+	saved, err := os.ReadFile(filepath.Join(dir, "TestCheck.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(saved) != "b\n" {
+		t.Errorf("saved %q, want %q", saved, "b\n")
+	}
+}
+
+// Records the failure, instead of failing the real test
+type fakeT struct {
+	testing.TB
+	msg string
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Fatalf(format string, args ...any) {
+	f.msg = fmt.Sprintf(format, args...)
+	runtime.Goexit()
+}
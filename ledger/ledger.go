@@ -0,0 +1,133 @@
+// Package ledger keeps the confirmed answers of every day, so reworking a solver can't silently change them.
+// Answers are keyed by day, part and a hash of the puzzle input, and stored in a JSON file.
+package ledger
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/input"
+)
+
+// Status is the outcome of checking an answer against the ledger.
+type Status int
+
+const (
+	New     Status = iota // no answer confirmed for this input yet
+	OK                    // matches the confirmed answer
+	Changed               // differs from the confirmed answer
+)
+
+func (s Status) String() string {
+	switch s {
+	case New:
+		return "NEW"
+	case OK:
+		return "OK"
+	case Changed:
+		return "CHANGED"
+	}
+	return fmt.Sprintf("Status(%d)", int(s))
+}
+
+// Entry is a confirmed answer.
+type Entry struct {
+	Day       int       `json:"day"`
+	Part      int       `json:"part"`
+	Input     string    `json:"input_sha256"`
+	Answer    string    `json:"answer"`
+	Confirmed time.Time `json:"confirmed"`
+}
+
+// Ledger is the set of confirmed answers, backed by a file.
+type Ledger struct {
+	path    string
+	entries []Entry
+}
+
+// Hash identifies a puzzle input. It hashes the input as the days read it with input.Text,
+// so copies of an input with CRLF line endings, or other trailing newlines, share their answers.
+func Hash(data []byte) string {
+	text, _ := input.Text(bytes.NewReader(data)) // reading from memory can't fail
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// Load reads the ledger at path. A missing file is an empty ledger.
+func Load(path string) (*Ledger, error) {
+	l := &Ledger{path: path}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return l, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not read ledger: %w", err)
+	}
+
+	if err := json.Unmarshal(data, &l.entries); err != nil {
+		return nil, fmt.Errorf("could not decode ledger %s: %w", path, err)
+	}
+	return l, nil
+}
+
+// Save writes the ledger back to its file, sorted by day and part.
+func (l *Ledger) Save() error {
+	slices.SortFunc(l.entries, func(a, b Entry) int {
+		return cmp.Or(cmp.Compare(a.Day, b.Day), cmp.Compare(a.Part, b.Part), cmp.Compare(a.Input, b.Input))
+	})
+
+	data, err := json.MarshalIndent(l.entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(l.path, append(data, '\n'), 0o644); err != nil {
+		return fmt.Errorf("could not write ledger: %w", err)
+	}
+	return nil
+}
+
+// Lookup returns the confirmed answer for a part of a day, solved with the input of the given hash.
+func (l *Ledger) Lookup(day, part int, input string) (Entry, bool) {
+	i := l.index(day, part, input)
+	if i == -1 {
+		return Entry{}, false
+	}
+	return l.entries[i], true
+}
+
+// Check compares an answer with the confirmed one, and returns the confirmed answer if there is one.
+func (l *Ledger) Check(day, part int, input, answer string) (Status, string) {
+	e, ok := l.Lookup(day, part, input)
+	switch {
+	case !ok:
+		return New, ""
+	case e.Answer == answer:
+		return OK, e.Answer
+	}
+	return Changed, e.Answer
+}
+
+// Confirm records an answer, replacing the previously confirmed one.
+func (l *Ledger) Confirm(day, part int, input, answer string) {
+	e := Entry{Day: day, Part: part, Input: input, Answer: answer, Confirmed: time.Now().UTC().Truncate(time.Second)}
+	if i := l.index(day, part, input); i != -1 {
+		l.entries[i] = e
+		return
+	}
+	l.entries = append(l.entries, e)
+}
+
+func (l *Ledger) index(day, part int, input string) int {
+	return slices.IndexFunc(l.entries, func(e Entry) bool {
+		return e.Day == day && e.Part == part && e.Input == input
+	})
+}
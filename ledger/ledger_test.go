@@ -0,0 +1,71 @@
+package ledger
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	l, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := Hash([]byte("125 17\n"))
+	l.Confirm(11, 1, input, "55312")
+
+	tests := []struct {
+		name          string
+		day, part     int
+		input, answer string
+		want          Status
+	}{
+		{"same answer", 11, 1, input, "55312", OK},
+		{"different answer", 11, 1, input, "55313", Changed},
+		{"other part", 11, 2, input, "55312", New},
+		{"other input", 11, 1, Hash([]byte("0 1\n")), "55312", New},
+		{"CRLF copy", 11, 1, Hash([]byte("125 17\r\n")), "55312", OK},
+		{"no trailing newline", 11, 1, Hash([]byte("125 17")), "55312", OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, _ := l.Check(tt.day, tt.part, tt.input, tt.answer); got != tt.want {
+				t.Errorf("Check() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// Confirming again replaces the answer
+	l.Confirm(11, 1, input, "55313")
+	if got, confirmed := l.Check(11, 1, input, "55313"); got != OK || confirmed != "55313" {
+		t.Errorf("Check() after a new confirm = %v, %q", got, confirmed)
+	}
+}
+
+func TestSaveAndLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "answers.json")
+	l, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	input := Hash([]byte("input"))
+	l.Confirm(2, 1, input, "4")
+	l.Confirm(1, 2, input, "31")
+	if err := l.Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.entries) != 2 || loaded.entries[0].Day != 1 {
+		t.Fatalf("loaded entries %v, want both answers sorted by day", loaded.entries)
+	}
+	if e, ok := loaded.Lookup(2, 1, input); !ok || e.Answer != "4" {
+		t.Errorf("Lookup() = %v, %v", e, ok)
+	}
+}
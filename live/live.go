@@ -0,0 +1,328 @@
+// Package live plays the frames of a simulation in the terminal, redrawing them in place with ANSI escape sequences.
+// Two rows of cells share a line of text, drawn as half blocks in the colours of the palette.
+//
+// Keys: space pauses, → or n steps forward, ← or p steps back, r rewinds to the first frame,
+// + and - change the speed, and q quits.
+package live
+
+import (
+	"bufio"
+	"fmt"
+	"image/color"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+)
+
+const (
+	cursorHome  = "\x1b[H"
+	clearScreen = "\x1b[2J"
+	clearLine   = "\x1b[K"
+	clearBelow  = "\x1b[J"
+	hideCursor  = "\x1b[?25l"
+	showCursor  = "\x1b[?25h"
+	reset       = "\x1b[0m"
+	inverse     = "\x1b[7m"
+	halfBlock   = "▀"
+)
+
+const help = "space pause  ←/→ step  r rewind  +/- speed  q quit"
+
+// Options change how the frames are played. Zero values use the defaults.
+type Options struct {
+	Palette color.Palette // colours the cells refer to, render.DefaultPalette if nil
+	FPS     int           // frames per second, 10 if 0
+	History int           // frames kept for stepping back, 1000 if 0
+}
+
+type key int
+
+const (
+	keyPause key = iota + 1
+	keyNext
+	keyPrev
+	keyRewind
+	keyFaster
+	keySlower
+	keyQuit
+)
+
+// View shows frames in the terminal as they are added. Adding a frame blocks until it's time for the next one,
+// so the simulation runs at the speed it's watched.
+type View struct {
+	out      io.Writer
+	keys     <-chan key
+	opts     Options
+	fg, bg   []string // escape sequences for every colour of the palette
+	history  []*render.Frame
+	dropped  int // frames dropped from the history
+	pos      int // index in history of the frame on screen
+	paused   bool
+	finished bool
+	quit     bool
+	next     time.Time // when the next frame is due
+	restore  func() error
+}
+
+// Open starts a view on the terminal, reading keys from stdin without echoing them.
+// The view has to be closed, to give the terminal back.
+func Open(opts Options) (*View, error) {
+	restore, err := rawMode()
+	if err != nil {
+		return nil, fmt.Errorf("live view needs a terminal: %w", err)
+	}
+
+	v := New(os.Stdin, os.Stdout, opts)
+	v.restore = restore
+	return v, nil
+}
+
+// New starts a view writing to out, reading keys from in if it's not nil.
+func New(in io.Reader, out io.Writer, opts Options) *View {
+	if opts.Palette == nil {
+		opts.Palette = render.DefaultPalette
+	}
+	if opts.FPS <= 0 {
+		opts.FPS = 10
+	}
+	if opts.History <= 0 {
+		opts.History = 1000
+	}
+
+	v := &View{out: out, opts: opts}
+	for _, c := range opts.Palette {
+		r, g, b, _ := c.RGBA()
+		v.fg = append(v.fg, fmt.Sprintf("\x1b[38;2;%d;%d;%dm", r>>8, g>>8, b>>8))
+		v.bg = append(v.bg, fmt.Sprintf("\x1b[48;2;%d;%d;%dm", r>>8, g>>8, b>>8))
+	}
+
+	if in != nil {
+		keys := make(chan key)
+		go readKeys(in, keys)
+		v.keys = keys
+	}
+
+	fmt.Fprint(out, hideCursor+clearScreen)
+	return v
+}
+
+// Add shows the next frame of the simulation. Once the view is quit, frames are ignored.
+func (v *View) Add(f *render.Frame) {
+	if v.quit {
+		return
+	}
+
+	v.history = append(v.history, f)
+	if len(v.history) > v.opts.History {
+		v.history = v.history[1:]
+		v.dropped++
+	}
+	v.pos = len(v.history) - 1
+	// Keep the pace, unless the simulation fell behind it
+	if now := time.Now(); v.next.Before(now) {
+		v.next = now
+	}
+	v.next = v.next.Add(v.interval())
+
+	v.draw()
+	v.play()
+}
+
+// Wait keeps the view open after the last frame, for stepping through the history, until it's quit.
+// Without keys to read, it returns once the last frame is shown.
+func (v *View) Wait() {
+	v.finished = true
+	if len(v.history) == 0 {
+		return
+	}
+	v.draw()
+	v.play()
+}
+
+// Close moves the cursor below the view, and gives the terminal back.
+func (v *View) Close() error {
+	fmt.Fprint(v.out, reset+showCursor+"\n")
+	if v.restore != nil {
+		return v.restore()
+	}
+	return nil
+}
+
+// Handles the keys until the next frame is due. Once finished, it only returns on quit (or without keys).
+func (v *View) play() {
+	for !v.quit {
+		last := v.pos == len(v.history)-1
+
+		var tick <-chan time.Time
+		if !v.paused && !(last && v.finished) {
+			tick = time.After(time.Until(v.next))
+		}
+		if tick == nil && v.keys == nil {
+			return
+		}
+
+		select {
+		case k, ok := <-v.keys:
+			if !ok {
+				// Nobody can unpause anymore
+				v.keys, v.paused = nil, false
+				continue
+			}
+			if k == keyNext && last && !v.finished {
+				return
+			}
+			v.handle(k)
+
+		case <-tick:
+			if last {
+				return
+			}
+			v.pos++
+			v.next = time.Now().Add(v.interval())
+			v.draw()
+		}
+	}
+}
+
+func (v *View) handle(k key) {
+	switch k {
+	case keyPause:
+		v.paused = !v.paused
+		v.next = time.Now().Add(v.interval())
+	case keyNext:
+		v.paused = true
+		v.pos = min(v.pos+1, len(v.history)-1)
+	case keyPrev:
+		v.paused = true
+		v.pos = max(v.pos-1, 0)
+	case keyRewind:
+		v.paused = true
+		v.pos = 0
+	case keyFaster:
+		v.opts.FPS = min(2*v.opts.FPS, 1000)
+	case keySlower:
+		v.opts.FPS = max(v.opts.FPS/2, 1)
+	case keyQuit:
+		v.quit = true
+		return
+	}
+	v.draw()
+}
+
+func (v *View) interval() time.Duration {
+	return time.Second / time.Duration(v.opts.FPS)
+}
+
+// Redraws the frame on screen, and the status line below it
+func (v *View) draw() {
+	f := v.history[v.pos]
+
+	var b strings.Builder
+	b.WriteString(cursorHome)
+	for y := 0; y < f.Height; y += 2 {
+		top, bottom := -1, -1
+		for x := range f.Width {
+			t, u := int(f.Cells[y*f.Width+x]), 0
+			if y+1 < f.Height {
+				u = int(f.Cells[(y+1)*f.Width+x])
+			}
+			// Only change the colours when they differ from the previous cell
+			if t != top && t < len(v.fg) {
+				b.WriteString(v.fg[t])
+			}
+			if u != bottom && u < len(v.bg) {
+				b.WriteString(v.bg[u])
+			}
+			top, bottom = t, u
+			b.WriteString(halfBlock)
+		}
+		b.WriteString(reset + clearLine + "\n")
+	}
+
+	state := "playing"
+	switch {
+	case v.paused:
+		state = "paused"
+	case v.finished && v.pos == len(v.history)-1:
+		state = "finished"
+	}
+	total := v.dropped + len(v.history)
+	fmt.Fprintf(&b, "%s frame %d/%d  %d fps  %s %s  %s%s\n", inverse, v.dropped+v.pos+1, total, v.opts.FPS, state, reset, f.Label, clearLine)
+	b.WriteString(help + clearLine + clearBelow)
+
+	io.WriteString(v.out, b.String())
+}
+
+// Reads key presses from in, until it's closed
+func readKeys(in io.Reader, keys chan<- key) {
+	defer close(keys)
+
+	r := bufio.NewReader(in)
+	for {
+		c, err := r.ReadByte()
+		if err != nil {
+			return
+		}
+
+		var k key
+		switch c {
+		case ' ':
+			k = keyPause
+		case 'n', 'l':
+			k = keyNext
+		case 'p', 'h':
+			k = keyPrev
+		case 'r':
+			k = keyRewind
+		case '+', '=':
+			k = keyFaster
+		case '-':
+			k = keySlower
+		case 'q', 3: // 3 is Ctrl-C, which doesn't send a signal in raw mode
+			k = keyQuit
+		case 0x1b:
+			// Arrow keys are sent as ESC [ C and ESC [ D
+			if c, _ := r.ReadByte(); c != '[' {
+				continue
+			}
+			switch c, _ := r.ReadByte(); c {
+			case 'C':
+				k = keyNext
+			case 'D':
+				k = keyPrev
+			}
+		}
+		if k != 0 {
+			keys <- k
+		}
+	}
+}
+
+// Switches the terminal to reading single key presses without echo, returns a function restoring it
+func rawMode() (func() error, error) {
+	saved, err := stty("-g")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := stty("-icanon", "-echo", "-isig", "min", "1"); err != nil {
+		return nil, err
+	}
+	return func() error {
+		_, err := stty(strings.TrimSpace(saved))
+		return err
+	}, nil
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("stty %s: %w", strings.Join(args, " "), err)
+	}
+	return string(out), nil
+}
@@ -0,0 +1,83 @@
+package live
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+	"github.com/SpicyHolo/advent_of_code_2024/render"
+)
+
+func frame(label string) *render.Frame {
+	f := render.NewFrame(3, 3)
+	f.Set(grid.Point{X: 1, Y: 1}, render.Green)
+	f.Label = label
+	return f
+}
+
+func TestPlay(t *testing.T) {
+	var out strings.Builder
+	v := New(nil, &out, Options{FPS: 1000})
+	for _, label := range []string{"first", "second", "third"} {
+		v.Add(frame(label))
+	}
+	v.Wait()
+	screen := out.String()
+	if err := v.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{"frame 3/3", "finished", "third", halfBlock} {
+		if !strings.Contains(screen, want) {
+			t.Errorf("output doesn't contain %q", want)
+		}
+	}
+	// 3 rows of cells fit on 2 lines, plus the status and the help
+	last := screen[strings.LastIndex(screen, cursorHome):]
+	if lines := strings.Count(last, "\n"); lines != 3 {
+		t.Errorf("last frame has %d line breaks, want 3", lines)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	keys := make(chan key)
+	go readKeys(strings.NewReader(" n\x1b[C\x1b[Dpr+-xq\x03"), keys)
+
+	var got []key
+	for k := range keys {
+		got = append(got, k)
+	}
+	want := []key{keyPause, keyNext, keyNext, keyPrev, keyPrev, keyRewind, keyFaster, keySlower, keyQuit, keyQuit}
+	if !slices.Equal(got, want) {
+		t.Errorf("keys = %v, want %v", got, want)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	keys := make(chan key, 10)
+	var out strings.Builder
+	v := New(nil, &out, Options{FPS: 1000, History: 2})
+	v.keys = keys
+
+	// Paused, every step forward past the last frame asks for the next one
+	for _, k := range []key{keyPause, keyNext, keyNext, keyRewind, keyQuit} {
+		keys <- k
+	}
+	for range 3 {
+		v.Add(frame(""))
+	}
+	if v.dropped != 1 || len(v.history) != 2 {
+		t.Fatalf("dropped %d, kept %d frames, want 1 and 2", v.dropped, len(v.history))
+	}
+	if v.pos != 0 || !v.quit {
+		t.Errorf("pos = %d, quit = %v after rewinding and quitting", v.pos, v.quit)
+	}
+
+	// Nothing happens once quit
+	v.Add(frame(""))
+	v.Wait()
+	if v.dropped+len(v.history) != 3 {
+		t.Errorf("got %d frames, want 3", v.dropped+len(v.history))
+	}
+}
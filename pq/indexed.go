@@ -0,0 +1,111 @@
+// Package pq provides a min priority queue, for graph searches such as Dijkstra's and A*.
+package pq
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// Indexed is a min priority queue, that keeps an index for every key in the queue.
+// Looking up, and lowering the priority of a queued key takes O(log n), instead of scanning the whole heap.
+// Smaller priority is popped first.
+type Indexed[K comparable, P cmp.Ordered] struct {
+	h entries[K, P]
+}
+
+type entry[K comparable, P cmp.Ordered] struct {
+	key      K
+	priority P
+	index    int
+}
+
+// entries implements heap.Interface, every entry keeps track of its own position in the heap
+type entries[K comparable, P cmp.Ordered] struct {
+	items []*entry[K, P]
+	index map[K]*entry[K, P]
+}
+
+// NewIndexed creates an empty queue.
+func NewIndexed[K comparable, P cmp.Ordered]() *Indexed[K, P] {
+	return &Indexed[K, P]{
+		h: entries[K, P]{index: make(map[K]*entry[K, P])},
+	}
+}
+
+// Len returns the number of keys in the queue.
+func (q *Indexed[K, P]) Len() int { return len(q.h.items) }
+
+// Contains reports whether the key is currently in the queue.
+func (q *Indexed[K, P]) Contains(key K) bool {
+	_, ok := q.h.index[key]
+	return ok
+}
+
+// Priority returns the priority of a queued key.
+func (q *Indexed[K, P]) Priority(key K) (P, bool) {
+	e, ok := q.h.index[key]
+	if !ok {
+		var zero P
+		return zero, false
+	}
+	return e.priority, true
+}
+
+// PushOrDecrease adds the key to the queue, or lowers its priority if it's already queued.
+// Returns false, and leaves the queue unchanged, if the key is queued with a priority lower or equal to the given one.
+func (q *Indexed[K, P]) PushOrDecrease(key K, priority P) bool {
+	e, ok := q.h.index[key]
+	if !ok {
+		heap.Push(&q.h, &entry[K, P]{key: key, priority: priority})
+		return true
+	}
+
+	if e.priority <= priority {
+		return false
+	}
+	e.priority = priority
+	heap.Fix(&q.h, e.index)
+	return true
+}
+
+// Pop removes and returns the key with the lowest priority.
+// It panics if the queue is empty.
+func (q *Indexed[K, P]) Pop() (K, P) {
+	e := heap.Pop(&q.h).(*entry[K, P])
+	return e.key, e.priority
+}
+
+// Peek returns the key with the lowest priority, without removing it.
+// It panics if the queue is empty.
+func (q *Indexed[K, P]) Peek() (K, P) {
+	e := q.h.items[0]
+	return e.key, e.priority
+}
+
+func (h entries[K, P]) Len() int { return len(h.items) }
+
+func (h entries[K, P]) Less(i, j int) bool {
+	return h.items[i].priority < h.items[j].priority
+}
+
+func (h entries[K, P]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *entries[K, P]) Push(x any) {
+	e := x.(*entry[K, P])
+	e.index = len(h.items)
+	h.items = append(h.items, e)
+	h.index[e.key] = e
+}
+
+func (h *entries[K, P]) Pop() any {
+	n := len(h.items)
+	e := h.items[n-1]
+	h.items[n-1] = nil // don't stop the GC from reclaiming the entry
+	h.items = h.items[:n-1]
+	delete(h.index, e.key)
+	return e
+}
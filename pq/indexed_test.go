@@ -0,0 +1,168 @@
+package pq
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+)
+
+func TestIndexedPopOrder(t *testing.T) {
+	q := NewIndexed[string, int]()
+	for key, priority := range map[string]int{"c": 3, "a": 1, "d": 4, "b": 2} {
+		if !q.PushOrDecrease(key, priority) {
+			t.Fatalf("PushOrDecrease(%q) = false for a new key", key)
+		}
+	}
+
+	if key, priority := q.Peek(); key != "a" || priority != 1 {
+		t.Errorf("Peek() = %v, %v, want a, 1", key, priority)
+	}
+
+	var got []string
+	for q.Len() > 0 {
+		key, _ := q.Pop()
+		if q.Contains(key) {
+			t.Errorf("Contains(%q) = true after popping it", key)
+		}
+		got = append(got, key)
+	}
+	if want := []string{"a", "b", "c", "d"}; !slices.Equal(got, want) {
+		t.Errorf("pop order = %v, want %v", got, want)
+	}
+}
+
+func TestIndexedPushOrDecrease(t *testing.T) {
+	q := NewIndexed[int, int]()
+	q.PushOrDecrease(1, 10)
+	q.PushOrDecrease(2, 5)
+
+	tests := []struct {
+		key, priority int
+		changed       bool
+		want          int
+	}{
+		{key: 1, priority: 20, changed: false, want: 10}, // never increases
+		{key: 1, priority: 10, changed: false, want: 10}, // equal is not an improvement
+		{key: 1, priority: 1, changed: true, want: 1},
+	}
+	for _, tt := range tests {
+		if changed := q.PushOrDecrease(tt.key, tt.priority); changed != tt.changed {
+			t.Errorf("PushOrDecrease(%d, %d) = %v, want %v", tt.key, tt.priority, changed, tt.changed)
+		}
+		if got, _ := q.Priority(tt.key); got != tt.want {
+			t.Errorf("Priority(%d) = %d, want %d", tt.key, got, tt.want)
+		}
+	}
+
+	if q.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", q.Len())
+	}
+	if key, _ := q.Pop(); key != 1 {
+		t.Errorf("Pop() = %d, want the decreased key 1", key)
+	}
+	if _, ok := q.Priority(1); ok {
+		t.Error("Priority(1) found a popped key")
+	}
+}
+
+func TestIndexedRandom(t *testing.T) {
+	testRandom(t, NewIndexed[int, int]())
+}
+
+func TestLinearRandom(t *testing.T) {
+	testRandom(t, NewLinear[int, int]())
+}
+
+// Pops every key in order of priority, with its lowest priority
+func testRandom(t *testing.T, q Queue[int, int]) {
+	rng := rand.New(rand.NewSource(1))
+	best := make(map[int]int)
+
+	for i := 0; i < 1000; i++ {
+		key, priority := rng.Intn(100), rng.Intn(1000)
+		q.PushOrDecrease(key, priority)
+		if old, ok := best[key]; !ok || priority < old {
+			best[key] = priority
+		}
+	}
+
+	prev := -1
+	for q.Len() > 0 {
+		key, priority := q.Pop()
+		if priority < prev {
+			t.Fatalf("popped priority %d after %d", priority, prev)
+		}
+		if best[key] != priority {
+			t.Fatalf("key %d popped with priority %d, want %d", key, priority, best[key])
+		}
+		delete(best, key)
+		prev = priority
+	}
+	if len(best) != 0 {
+		t.Errorf("%d keys were never popped", len(best))
+	}
+}
+
+// Weighted grid, so the frontier of the search holds plenty of keys
+func benchGrid(size int) [][]int {
+	rng := rand.New(rand.NewSource(2024))
+	grid := make([][]int, size)
+	for y := range grid {
+		grid[y] = make([]int, size)
+		for x := range grid[y] {
+			grid[y][x] = 1 + rng.Intn(9)
+		}
+	}
+	return grid
+}
+
+var dirs = [][2]int{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+
+func dijkstra(grid [][]int, q Queue[[2]int, int]) int {
+	size := len(grid)
+	visited := make(map[[2]int]bool)
+	q.PushOrDecrease([2]int{0, 0}, 0)
+
+	for q.Len() > 0 {
+		pos, cost := q.Pop()
+		if pos == [2]int{size - 1, size - 1} {
+			return cost
+		}
+		visited[pos] = true
+
+		for _, d := range dirs {
+			next := [2]int{pos[0] + d[0], pos[1] + d[1]}
+			if next[0] < 0 || next[1] < 0 || next[0] >= size || next[1] >= size || visited[next] {
+				continue
+			}
+			q.PushOrDecrease(next, cost+grid[next[1]][next[0]])
+		}
+	}
+	return -1
+}
+
+func TestDijkstraAgrees(t *testing.T) {
+	grid := benchGrid(30)
+	if got, want := dijkstra(grid, NewIndexed[[2]int, int]()), dijkstra(grid, NewLinear[[2]int, int]()); got != want {
+		t.Errorf("indexed queue found cost %d, linear queue %d", got, want)
+	}
+}
+
+// Random weighted grids the size of the memory space of day 18 (71x71), and the mazes and racetracks of days 16 and 20 (141x141).
+// The days themselves are benchmarked with both queues on generated full-size inputs, by BenchmarkParts in their packages.
+func BenchmarkDijkstra(b *testing.B) {
+	for _, size := range []int{71, 141} {
+		grid := benchGrid(size)
+		b.Run(fmt.Sprintf("indexed/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(grid, NewIndexed[[2]int, int]())
+			}
+		})
+		b.Run(fmt.Sprintf("linear/%d", size), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				dijkstra(grid, NewLinear[[2]int, int]())
+			}
+		})
+	}
+}
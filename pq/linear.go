@@ -0,0 +1,79 @@
+package pq
+
+import (
+	"cmp"
+	"container/heap"
+)
+
+// Queue is what the searches need from a min priority queue.
+type Queue[K comparable, P cmp.Ordered] interface {
+	Len() int
+	PushOrDecrease(key K, priority P) bool
+	Pop() (K, P)
+}
+
+// Linear is the queue the days used before Indexed: it scans the whole heap to find a queued key,
+// so PushOrDecrease takes O(n). It's kept as a baseline, to benchmark Indexed against.
+type Linear[K comparable, P cmp.Ordered] struct {
+	h linearEntries[K, P]
+}
+
+type linearEntries[K comparable, P cmp.Ordered] []*entry[K, P]
+
+// NewLinear creates an empty queue.
+func NewLinear[K comparable, P cmp.Ordered]() *Linear[K, P] {
+	return &Linear[K, P]{}
+}
+
+// Len returns the number of keys in the queue.
+func (q *Linear[K, P]) Len() int { return len(q.h) }
+
+// PushOrDecrease adds the key to the queue, or lowers its priority if it's already queued.
+// Returns false, and leaves the queue unchanged, if the key is queued with a priority lower or equal to the given one.
+func (q *Linear[K, P]) PushOrDecrease(key K, priority P) bool {
+	for _, e := range q.h {
+		if e.key != key {
+			continue
+		}
+		if e.priority <= priority {
+			return false
+		}
+		e.priority = priority
+		heap.Fix(&q.h, e.index)
+		return true
+	}
+
+	heap.Push(&q.h, &entry[K, P]{key: key, priority: priority})
+	return true
+}
+
+// Pop removes and returns the key with the lowest priority.
+// It panics if the queue is empty.
+func (q *Linear[K, P]) Pop() (K, P) {
+	e := heap.Pop(&q.h).(*entry[K, P])
+	return e.key, e.priority
+}
+
+func (h linearEntries[K, P]) Len() int           { return len(h) }
+func (h linearEntries[K, P]) Less(i, j int) bool { return h[i].priority < h[j].priority }
+
+func (h linearEntries[K, P]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+
+func (h *linearEntries[K, P]) Push(x any) {
+	e := x.(*entry[K, P])
+	e.index = len(*h)
+	*h = append(*h, e)
+}
+
+func (h *linearEntries[K, P]) Pop() any {
+	old := *h
+	n := len(old)
+	e := old[n-1]
+	old[n-1] = nil
+	*h = old[:n-1]
+	return e
+}
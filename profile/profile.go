@@ -0,0 +1,92 @@
+// Package profile captures CPU and memory profiles, and execution traces, of solver runs.
+// Profiles are read back by a small decoder of the pprof format, to show the top functions without go tool pprof.
+package profile
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+	"runtime/trace"
+)
+
+// Options are the files to capture into, empty ones are not captured.
+type Options struct {
+	CPU, Mem, Trace string
+}
+
+// Session is a capture in progress.
+type Session struct {
+	opts       Options
+	cpu, trace *os.File
+}
+
+// Start starts capturing the CPU profile and the trace. The memory profile is written by Stop.
+func Start(opts Options) (*Session, error) {
+	s := &Session{opts: opts}
+
+	if opts.CPU != "" {
+		f, err := os.Create(opts.CPU)
+		if err != nil {
+			return nil, fmt.Errorf("could not create CPU profile: %w", err)
+		}
+		if err := pprof.StartCPUProfile(f); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("could not start CPU profile: %w", err)
+		}
+		s.cpu = f
+	}
+
+	if opts.Trace != "" {
+		f, err := os.Create(opts.Trace)
+		if err == nil {
+			err = trace.Start(f)
+			if err != nil {
+				f.Close()
+			}
+		}
+		if err != nil {
+			s.Stop()
+			return nil, fmt.Errorf("could not start trace: %w", err)
+		}
+		s.trace = f
+	}
+	return s, nil
+}
+
+// Stop ends the capture, and writes every file.
+func (s *Session) Stop() error {
+	var errs []error
+	if s.cpu != nil {
+		pprof.StopCPUProfile()
+		errs = append(errs, s.cpu.Close())
+		s.cpu = nil
+	}
+	if s.trace != nil {
+		trace.Stop()
+		errs = append(errs, s.trace.Close())
+		s.trace = nil
+	}
+
+	if s.opts.Mem != "" {
+		errs = append(errs, writeMemProfile(s.opts.Mem))
+	}
+	return errors.Join(errs...)
+}
+
+// Writes every allocation sampled since the program started
+func writeMemProfile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("could not create memory profile: %w", err)
+	}
+	defer f.Close()
+
+	// The profile is only up to date as of the last garbage collection
+	runtime.GC()
+	if err := pprof.Lookup("allocs").WriteTo(f, 0); err != nil {
+		return fmt.Errorf("could not write memory profile: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,195 @@
+package profile
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// Encodes protocol buffers fields, enough to write small profiles by hand
+type encoder struct{ bytes.Buffer }
+
+func (e *encoder) varint(v uint64) {
+	for v >= 0x80 {
+		e.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	e.WriteByte(byte(v))
+}
+
+func (e *encoder) int(num int, v uint64) {
+	e.varint(uint64(num)<<3 | wireVarint)
+	e.varint(v)
+}
+
+func (e *encoder) bytes(num int, b []byte) {
+	e.varint(uint64(num)<<3 | wireBytes)
+	e.varint(uint64(len(b)))
+	e.Write(b)
+}
+
+func (e *encoder) message(num int, fill func(m *encoder)) {
+	var m encoder
+	fill(&m)
+	e.bytes(num, m.Bytes())
+}
+
+// A profile of main calling solve, which calls itself and step, with step inlined into solve once
+func handmade() []byte {
+	var e encoder
+	for _, s := range []string{"", "samples", "count", "cpu", "nanoseconds", "main", "solve", "step"} {
+		e.bytes(profileStringTable, []byte(s))
+	}
+	e.message(profileSampleType, func(m *encoder) { m.int(valueTypeType, 1); m.int(valueTypeUnit, 2) })
+	e.message(profileSampleType, func(m *encoder) { m.int(valueTypeType, 3); m.int(valueTypeUnit, 4) })
+
+	for id, name := range []uint64{5, 6, 7} {
+		e.message(profileFunction, func(m *encoder) { m.int(functionID, uint64(id+1)); m.int(functionName, name) })
+	}
+
+	// Location 1 is main, 2 solve, 3 step inlined in solve
+	e.message(profileLocation, func(m *encoder) {
+		m.int(locationID, 1)
+		m.message(locationLine, func(l *encoder) { l.int(lineFunctionID, 1); l.int(lineLine, 10) })
+	})
+	e.message(profileLocation, func(m *encoder) {
+		m.int(locationID, 2)
+		m.message(locationLine, func(l *encoder) { l.int(lineFunctionID, 2); l.int(lineLine, 20) })
+	})
+	e.message(profileLocation, func(m *encoder) {
+		m.int(locationID, 3)
+		m.message(locationLine, func(l *encoder) { l.int(lineFunctionID, 3); l.int(lineLine, 30) })
+		m.message(locationLine, func(l *encoder) { l.int(lineFunctionID, 2); l.int(lineLine, 21) })
+	})
+
+	sample := func(value uint64, stack ...uint64) {
+		e.message(profileSample, func(m *encoder) {
+			// Packed locations, unpacked values
+			var packed encoder
+			for _, id := range stack {
+				packed.varint(id)
+			}
+			m.bytes(sampleLocationID, packed.Bytes())
+			m.int(sampleValue, 1)
+			m.int(sampleValue, value)
+		})
+	}
+	sample(30e6, 3, 2, 1)    // step, inlined in solve
+	sample(50e6, 2, 2, 2, 1) // recursive solve
+	sample(20e6, 1)          // main
+
+	return e.Bytes()
+}
+
+func TestTop(t *testing.T) {
+	p, err := Parse(bytes.NewReader(handmade()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, total, err := p.Top("", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Entry{
+		{"solve", 50e6, 80e6},
+		{"step", 30e6, 30e6},
+		{"main", 20e6, 100e6},
+	}
+	if total != 100e6 {
+		t.Errorf("total = %d, want %d", total, int64(100e6))
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Top() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Top()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	// Samples counts every sample once
+	if got, _, _ := p.Top("samples", 1); len(got) != 1 || got[0].Flat != 1 {
+		t.Errorf("Top(samples, 1) = %v, want a single function of 1 sample", got)
+	}
+	if _, _, err := p.Top("alloc_space", 1); err == nil {
+		t.Error("expected an error for a missing sample type")
+	}
+
+	var out strings.Builder
+	if err := p.WriteTop(&out, "cpu", 2); err != nil {
+		t.Fatal(err)
+	}
+	if s := out.String(); !strings.Contains(s, "top 2 of cpu, total 100ms") || !strings.Contains(s, "50ms  50.00%  50.00%       80ms  80.00%  solve") {
+		t.Errorf("WriteTop() =\n%s", s)
+	}
+}
+
+func TestParseTruncated(t *testing.T) {
+	data := handmade()
+	if _, err := Parse(bytes.NewReader(data[:len(data)-3])); err == nil {
+		t.Error("expected an error for a truncated profile")
+	}
+}
+
+var sink [][]byte
+
+//go:noinline
+func allocate() {
+	for range 100 {
+		sink = append(sink, make([]byte, 64<<10))
+	}
+}
+
+func TestMemProfile(t *testing.T) {
+	defer func(rate int) { runtime.MemProfileRate = rate }(runtime.MemProfileRate)
+	runtime.MemProfileRate = 1
+
+	path := filepath.Join(t.TempDir(), "mem.pprof")
+	s, err := Start(Options{Mem: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+	allocate()
+	if err := s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	p, err := Parse(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	top, _, err := p.Top("alloc_space", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range top {
+		if strings.HasSuffix(e.Function, "profile.allocate") && e.Flat >= 100*64<<10 {
+			return
+		}
+	}
+	t.Errorf("allocate() is missing from the top allocations %v", top)
+}
+
+func TestShortName(t *testing.T) {
+	tests := map[string]string{
+		"main.solve": "main.solve",
+		"github.com/SpicyHolo/advent_of_code_2024/12.Set[go.shape.struct { X int; Y int }].add": "github.com/SpicyHolo/advent_of_code_2024/12.Set[...].add",
+		"pq.(*Indexed[go.shape.struct { X int },go.shape.int]).Pop":                             "pq.(*Indexed[...]).Pop",
+		"grid.Parse[go.shape.map[int][]int]":                                                    "grid.Parse[...]",
+	}
+	for name, want := range tests {
+		if got := shortName(name); got != want {
+			t.Errorf("shortName(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
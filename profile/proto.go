@@ -0,0 +1,471 @@
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Profile is the part of a pprof profile needed to sum samples per function.
+type Profile struct {
+	SampleTypes       []ValueType
+	DefaultSampleType string
+	Samples           []Sample
+	Locations         map[uint64]Location
+	Functions         map[uint64]Function
+}
+
+// ValueType names the values of the samples, such as cpu in nanoseconds.
+type ValueType struct {
+	Type, Unit string
+}
+
+// Sample is a stack, leaf first, with a value for every sample type.
+type Sample struct {
+	Locations []uint64
+	Values    []int64
+}
+
+// Location is a place in the code. Inlined calls give it several lines, the innermost first.
+type Location struct {
+	Lines []Line
+}
+
+// Line is a line of a function.
+type Line struct {
+	Function uint64
+	Line     int64
+}
+
+// Function is a function of the profiled program.
+type Function struct {
+	Name, File string
+}
+
+// Field numbers of profile.proto, github.com/google/pprof/proto/profile.proto
+const (
+	profileSampleType        = 1
+	profileSample            = 2
+	profileLocation          = 4
+	profileFunction          = 5
+	profileStringTable       = 6
+	profileDefaultSampleType = 14
+
+	valueTypeType = 1
+	valueTypeUnit = 2
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+	lineLine       = 2
+
+	functionID       = 1
+	functionName     = 2
+	functionFilename = 4
+)
+
+// Wire types of protocol buffers
+const (
+	wireVarint  = 0
+	wireFixed64 = 1
+	wireBytes   = 2
+	wireFixed32 = 5
+)
+
+var errTruncated = errors.New("truncated profile")
+
+// Parse decodes a profile, as written by runtime/pprof. Gzipped profiles are uncompressed first.
+func Parse(r io.Reader) (*Profile, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("could not read profile: %w", err)
+	}
+	if len(data) >= 2 && data[0] == 0x1f && data[1] == 0x8b {
+		zr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("could not uncompress profile: %w", err)
+		}
+		if data, err = io.ReadAll(zr); err != nil {
+			return nil, fmt.Errorf("could not uncompress profile: %w", err)
+		}
+	}
+
+	p, err := decodeProfile(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode profile: %w", err)
+	}
+	return p, nil
+}
+
+// Strings are indexes into the string table, which may come last, so they're resolved at the end
+type rawValueType struct{ typ, unit int64 }
+type rawFunction struct{ name, file int64 }
+
+func decodeProfile(data []byte) (*Profile, error) {
+	p := &Profile{Locations: make(map[uint64]Location), Functions: make(map[uint64]Function)}
+	var (
+		sampleTypes       []rawValueType
+		functions         = make(map[uint64]rawFunction)
+		table             []string
+		defaultSampleType int64
+	)
+
+	d := decoder{data}
+	for !d.done() {
+		num, wire, err := d.key()
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case num == profileSampleType && wire == wireBytes:
+			msg, err := d.bytes()
+			if err != nil {
+				return nil, err
+			}
+			vt, err := decodeValueType(msg)
+			if err != nil {
+				return nil, err
+			}
+			sampleTypes = append(sampleTypes, vt)
+
+		case num == profileSample && wire == wireBytes:
+			msg, err := d.bytes()
+			if err != nil {
+				return nil, err
+			}
+			s, err := decodeSample(msg)
+			if err != nil {
+				return nil, err
+			}
+			p.Samples = append(p.Samples, s)
+
+		case num == profileLocation && wire == wireBytes:
+			msg, err := d.bytes()
+			if err != nil {
+				return nil, err
+			}
+			id, loc, err := decodeLocation(msg)
+			if err != nil {
+				return nil, err
+			}
+			p.Locations[id] = loc
+
+		case num == profileFunction && wire == wireBytes:
+			msg, err := d.bytes()
+			if err != nil {
+				return nil, err
+			}
+			id, f, err := decodeFunction(msg)
+			if err != nil {
+				return nil, err
+			}
+			functions[id] = f
+
+		case num == profileStringTable && wire == wireBytes:
+			s, err := d.bytes()
+			if err != nil {
+				return nil, err
+			}
+			table = append(table, string(s))
+
+		case num == profileDefaultSampleType && wire == wireVarint:
+			v, err := d.varint()
+			if err != nil {
+				return nil, err
+			}
+			defaultSampleType = int64(v)
+
+		default:
+			if err := d.skip(wire); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	str := func(i int64) (string, error) {
+		if i < 0 || i >= int64(len(table)) {
+			return "", fmt.Errorf("string %d out of the table of %d", i, len(table))
+		}
+		return table[i], nil
+	}
+
+	var err error
+	p.SampleTypes = make([]ValueType, len(sampleTypes))
+	for i, vt := range sampleTypes {
+		if p.SampleTypes[i].Type, err = str(vt.typ); err != nil {
+			return nil, err
+		}
+		if p.SampleTypes[i].Unit, err = str(vt.unit); err != nil {
+			return nil, err
+		}
+	}
+	if p.DefaultSampleType, err = str(defaultSampleType); err != nil {
+		return nil, err
+	}
+	for id, f := range functions {
+		var fn Function
+		if fn.Name, err = str(f.name); err != nil {
+			return nil, err
+		}
+		if fn.File, err = str(f.file); err != nil {
+			return nil, err
+		}
+		p.Functions[id] = fn
+	}
+	return p, nil
+}
+
+func decodeValueType(data []byte) (rawValueType, error) {
+	var vt rawValueType
+	d := decoder{data}
+	for !d.done() {
+		num, wire, err := d.key()
+		if err != nil {
+			return vt, err
+		}
+		if wire != wireVarint {
+			if err := d.skip(wire); err != nil {
+				return vt, err
+			}
+			continue
+		}
+
+		v, err := d.varint()
+		if err != nil {
+			return vt, err
+		}
+		switch num {
+		case valueTypeType:
+			vt.typ = int64(v)
+		case valueTypeUnit:
+			vt.unit = int64(v)
+		}
+	}
+	return vt, nil
+}
+
+func decodeSample(data []byte) (Sample, error) {
+	var s Sample
+	d := decoder{data}
+	for !d.done() {
+		num, wire, err := d.key()
+		if err != nil {
+			return s, err
+		}
+		switch num {
+		case sampleLocationID:
+			if s.Locations, err = d.repeated(wire, s.Locations); err != nil {
+				return s, err
+			}
+		case sampleValue:
+			values, err := d.repeated(wire, nil)
+			if err != nil {
+				return s, err
+			}
+			for _, v := range values {
+				s.Values = append(s.Values, int64(v))
+			}
+		default:
+			if err := d.skip(wire); err != nil {
+				return s, err
+			}
+		}
+	}
+	return s, nil
+}
+
+func decodeLocation(data []byte) (uint64, Location, error) {
+	var (
+		id  uint64
+		loc Location
+	)
+	d := decoder{data}
+	for !d.done() {
+		num, wire, err := d.key()
+		if err != nil {
+			return 0, loc, err
+		}
+		switch {
+		case num == locationID && wire == wireVarint:
+			if id, err = d.varint(); err != nil {
+				return 0, loc, err
+			}
+		case num == locationLine && wire == wireBytes:
+			msg, err := d.bytes()
+			if err != nil {
+				return 0, loc, err
+			}
+			line, err := decodeLine(msg)
+			if err != nil {
+				return 0, loc, err
+			}
+			loc.Lines = append(loc.Lines, line)
+		default:
+			if err := d.skip(wire); err != nil {
+				return 0, loc, err
+			}
+		}
+	}
+	return id, loc, nil
+}
+
+func decodeLine(data []byte) (Line, error) {
+	var line Line
+	d := decoder{data}
+	for !d.done() {
+		num, wire, err := d.key()
+		if err != nil {
+			return line, err
+		}
+		switch {
+		case num == lineFunctionID && wire == wireVarint:
+			if line.Function, err = d.varint(); err != nil {
+				return line, err
+			}
+		case num == lineLine && wire == wireVarint:
+			v, err := d.varint()
+			if err != nil {
+				return line, err
+			}
+			line.Line = int64(v)
+		default:
+			if err := d.skip(wire); err != nil {
+				return line, err
+			}
+		}
+	}
+	return line, nil
+}
+
+func decodeFunction(data []byte) (uint64, rawFunction, error) {
+	var (
+		id uint64
+		f  rawFunction
+	)
+	d := decoder{data}
+	for !d.done() {
+		num, wire, err := d.key()
+		if err != nil {
+			return 0, f, err
+		}
+
+		if wire != wireVarint {
+			if err := d.skip(wire); err != nil {
+				return 0, f, err
+			}
+			continue
+		}
+
+		v, err := d.varint()
+		if err != nil {
+			return 0, f, err
+		}
+		switch num {
+		case functionID:
+			id = v
+		case functionName:
+			f.name = int64(v)
+		case functionFilename:
+			f.file = int64(v)
+		}
+	}
+	return id, f, nil
+}
+
+// Reads the protocol buffers wire format
+type decoder struct {
+	data []byte
+}
+
+func (d *decoder) done() bool { return len(d.data) == 0 }
+
+func (d *decoder) varint() (uint64, error) {
+	var v uint64
+	for i, b := range d.data {
+		if i == 10 {
+			return 0, errors.New("varint overflows 64 bits")
+		}
+		v |= uint64(b&0x7f) << (7 * i)
+		if b < 0x80 {
+			d.data = d.data[i+1:]
+			return v, nil
+		}
+	}
+	return 0, errTruncated
+}
+
+// Field number and wire type of the next field
+func (d *decoder) key() (int, int, error) {
+	k, err := d.varint()
+	if err != nil {
+		return 0, 0, err
+	}
+	return int(k >> 3), int(k & 7), nil
+}
+
+func (d *decoder) bytes() ([]byte, error) {
+	n, err := d.varint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(d.data)) {
+		return nil, errTruncated
+	}
+	b := d.data[:n]
+	d.data = d.data[n:]
+	return b, nil
+}
+
+// Appends a repeated integer field, either a single varint or packed varints
+func (d *decoder) repeated(wire int, values []uint64) ([]uint64, error) {
+	switch wire {
+	case wireVarint:
+		v, err := d.varint()
+		return append(values, v), err
+	case wireBytes:
+		packed, err := d.bytes()
+		if err != nil {
+			return values, err
+		}
+		pd := decoder{packed}
+		for !pd.done() {
+			v, err := pd.varint()
+			if err != nil {
+				return values, err
+			}
+			values = append(values, v)
+		}
+		return values, nil
+	}
+	return values, fmt.Errorf("unexpected wire type %d for a repeated integer", wire)
+}
+
+func (d *decoder) skip(wire int) error {
+	var n int
+	switch wire {
+	case wireVarint:
+		_, err := d.varint()
+		return err
+	case wireBytes:
+		_, err := d.bytes()
+		return err
+	case wireFixed64:
+		n = 8
+	case wireFixed32:
+		n = 4
+	default:
+		return fmt.Errorf("unknown wire type %d", wire)
+	}
+	if n > len(d.data) {
+		return errTruncated
+	}
+	d.data = d.data[n:]
+	return nil
+}
@@ -0,0 +1,158 @@
+package profile
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Entry is the share of a function in a profile. Flat counts the samples in the function itself,
+// cumulative ones also count the functions it calls.
+type Entry struct {
+	Function  string
+	Flat, Cum int64
+}
+
+// Index of a sample type by name. An empty name is the default type, or the last one like go tool pprof.
+func (p *Profile) index(sampleType string) (int, error) {
+	if sampleType == "" {
+		sampleType = p.DefaultSampleType
+	}
+	if sampleType == "" && len(p.SampleTypes) > 0 {
+		return len(p.SampleTypes) - 1, nil
+	}
+
+	i := slices.IndexFunc(p.SampleTypes, func(vt ValueType) bool { return vt.Type == sampleType })
+	if i == -1 {
+		return 0, fmt.Errorf("no %q samples in the profile, has %v", sampleType, p.SampleTypes)
+	}
+	return i, nil
+}
+
+// Top sums the samples of a type per function, and returns the n functions with the largest flat values,
+// along with the total of all samples.
+func (p *Profile) Top(sampleType string, n int) ([]Entry, int64, error) {
+	idx, err := p.index(sampleType)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	flat := make(map[string]int64)
+	cum := make(map[string]int64)
+	var total int64
+	for _, s := range p.Samples {
+		if idx >= len(s.Values) || s.Values[idx] == 0 {
+			continue
+		}
+		v := s.Values[idx]
+		total += v
+
+		// Recursive functions show up several times in a stack, but only count once
+		seen := make(map[string]bool)
+		for i, id := range s.Locations {
+			for j, line := range p.Locations[id].Lines {
+				name := shortName(p.Functions[line.Function].Name)
+				if i == 0 && j == 0 {
+					flat[name] += v
+				}
+				if !seen[name] {
+					seen[name] = true
+					cum[name] += v
+				}
+			}
+		}
+	}
+
+	entries := make([]Entry, 0, len(cum))
+	for name, c := range cum {
+		entries = append(entries, Entry{Function: name, Flat: flat[name], Cum: c})
+	}
+	slices.SortFunc(entries, func(a, b Entry) int {
+		return cmp.Or(cmp.Compare(b.Flat, a.Flat), cmp.Compare(b.Cum, a.Cum), cmp.Compare(a.Function, b.Function))
+	})
+	if n > 0 && n < len(entries) {
+		entries = entries[:n]
+	}
+	return entries, total, nil
+}
+
+// Drops the type arguments of generic functions, go.shape.struct { ... } is just noise
+func shortName(name string) string {
+	var b strings.Builder
+	depth := 0
+	for _, c := range name {
+		switch {
+		case c == '[':
+			if depth == 0 {
+				b.WriteString("[...]")
+			}
+			depth++
+		case c == ']':
+			depth--
+		case depth == 0:
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+// WriteTop writes the top n functions of a sample type as a table, like go tool pprof's top command.
+func (p *Profile) WriteTop(w io.Writer, sampleType string, n int) error {
+	idx, err := p.index(sampleType)
+	if err != nil {
+		return err
+	}
+	entries, total, err := p.Top(sampleType, n)
+	if err != nil {
+		return err
+	}
+
+	vt := p.SampleTypes[idx]
+	format := func(v int64) string { return formatValue(v, vt.Unit) }
+	percent := func(v int64) float64 {
+		if total == 0 {
+			return 0
+		}
+		return 100 * float64(v) / float64(total)
+	}
+
+	if _, err := fmt.Fprintf(w, "top %d of %s, total %s\n", len(entries), vt.Type, format(total)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(w, "%10s %7s %7s %10s %7s  %s\n", "flat", "flat%", "sum%", "cum", "cum%", "function"); err != nil {
+		return err
+	}
+	var sum int64
+	for _, e := range entries {
+		sum += e.Flat
+		_, err := fmt.Fprintf(w, "%10s %6.2f%% %6.2f%% %10s %6.2f%%  %s\n",
+			format(e.Flat), percent(e.Flat), percent(sum), format(e.Cum), percent(e.Cum), e.Function)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Formats a value in its unit, durations and sizes the way pprof does
+func formatValue(v int64, unit string) string {
+	switch unit {
+	case "nanoseconds":
+		return time.Duration(v).Round(time.Millisecond / 10).String()
+	case "bytes":
+		const k = 1024
+		switch {
+		case v >= k*k*k:
+			return fmt.Sprintf("%.2fGB", float64(v)/(k*k*k))
+		case v >= k*k:
+			return fmt.Sprintf("%.2fMB", float64(v)/(k*k))
+		case v >= k:
+			return fmt.Sprintf("%.2fkB", float64(v)/k)
+		}
+		return fmt.Sprintf("%dB", v)
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,172 @@
+// Package render turns the simulations of the puzzles into animated GIFs.
+// Every frame is a small picture with one palette colour per cell, scaled up when it's encoded.
+package render
+
+import (
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"io"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+)
+
+// Indices of the colours in DefaultPalette
+const (
+	Black uint8 = iota
+	White
+	Grey
+	Green
+	Red
+	Yellow
+	Blue
+)
+
+// DefaultPalette is used when Options has no palette. Index 0 is the background.
+var DefaultPalette = color.Palette{
+	color.RGBA{0x00, 0x00, 0x00, 0xff},
+	color.RGBA{0xff, 0xff, 0xff, 0xff},
+	color.RGBA{0x55, 0x55, 0x55, 0xff},
+	color.RGBA{0x00, 0xdd, 0x00, 0xff},
+	color.RGBA{0xee, 0x22, 0x22, 0xff},
+	color.RGBA{0xff, 0xcc, 0x00, 0xff},
+	color.RGBA{0x33, 0x66, 0xff, 0xff},
+}
+
+// Options change how an animation is drawn. Zero values use the defaults.
+type Options struct {
+	Palette color.Palette // colours the cells refer to, DefaultPalette if nil
+	Scale   int           // size of a cell in pixels, 4 if 0
+	Delay   int           // time between frames in 100ths of a second, 5 if 0
+	Every   int           // keep every n-th frame, plus the last one
+	Max     int           // stop after this many frames, no limit if 0
+}
+
+// Frame is one picture of an animation, holding a palette index per cell.
+type Frame struct {
+	Width, Height int
+	Cells         []uint8
+	Label         string // describes the state, such as the step of the simulation
+}
+
+// Sink receives the frames of a simulation, such as an Animation or a live view in the terminal.
+type Sink interface {
+	Add(f *Frame)
+}
+
+// NewFrame creates a frame filled with the background colour.
+func NewFrame(width, height int) *Frame {
+	return &Frame{Width: width, Height: height, Cells: make([]uint8, width*height)}
+}
+
+// Set colours the cell at p. Points outside the frame are ignored.
+func (f *Frame) Set(p grid.Point, c uint8) {
+	if p.X < 0 || p.Y < 0 || p.X >= f.Width || p.Y >= f.Height {
+		return
+	}
+	f.Cells[p.Y*f.Width+p.X] = c
+}
+
+// GridFrame draws a grid, picking the colour of every cell with colour.
+func GridFrame[T comparable](g *grid.Grid[T], colour func(p grid.Point, v T) uint8) *Frame {
+	f := NewFrame(g.Width(), g.Height())
+	for p, v := range g.All() {
+		f.Set(p, colour(p, v))
+	}
+	return f
+}
+
+// PointsFrame draws the points in colour c on an empty width x height frame, such as robots at their positions.
+func PointsFrame(width, height int, points []grid.Point, c uint8) *Frame {
+	f := NewFrame(width, height)
+	for _, p := range points {
+		f.Set(p, c)
+	}
+	return f
+}
+
+// Animation collects frames, and encodes them as a GIF.
+type Animation struct {
+	opts    Options
+	images  []*image.Paletted
+	seen    int
+	skipped *Frame // last frame dropped by Every, added back at the end
+}
+
+// New creates an empty animation.
+func New(opts Options) *Animation {
+	if opts.Palette == nil {
+		opts.Palette = DefaultPalette
+	}
+	if opts.Scale <= 0 {
+		opts.Scale = 4
+	}
+	if opts.Delay <= 0 {
+		opts.Delay = 5
+	}
+	if opts.Every <= 0 {
+		opts.Every = 1
+	}
+	return &Animation{opts: opts}
+}
+
+// Add appends a frame, unless Every skips it or Max frames were already added.
+func (a *Animation) Add(f *Frame) {
+	if a.full() {
+		return
+	}
+
+	a.seen++
+	if (a.seen-1)%a.opts.Every != 0 {
+		a.skipped = f
+		return
+	}
+	a.skipped = nil
+	a.images = append(a.images, a.paletted(f))
+}
+
+// Len is the number of frames in the animation.
+func (a *Animation) Len() int {
+	if a.skipped != nil && !a.full() {
+		return len(a.images) + 1
+	}
+	return len(a.images)
+}
+
+func (a *Animation) full() bool {
+	return a.opts.Max > 0 && len(a.images) >= a.opts.Max
+}
+
+// Encode writes the animation as a looping GIF.
+func (a *Animation) Encode(w io.Writer) error {
+	images := a.images
+	if a.skipped != nil && !a.full() {
+		images = append(images, a.paletted(a.skipped))
+	}
+	if len(images) == 0 {
+		return errors.New("animation has no frames")
+	}
+
+	delays := make([]int, len(images))
+	for i := range delays {
+		delays[i] = a.opts.Delay
+	}
+	// Hold the final state a bit longer, before looping
+	delays[len(delays)-1] = 10 * a.opts.Delay
+
+	return gif.EncodeAll(w, &gif.GIF{Image: images, Delay: delays})
+}
+
+// Scales a frame up into an image
+func (a *Animation) paletted(f *Frame) *image.Paletted {
+	s := a.opts.Scale
+	img := image.NewPaletted(image.Rect(0, 0, f.Width*s, f.Height*s), a.opts.Palette)
+	for y := range img.Rect.Dy() {
+		row := f.Cells[(y/s)*f.Width:]
+		for x := range img.Rect.Dx() {
+			img.Pix[y*img.Stride+x] = row[x/s]
+		}
+	}
+	return img
+}
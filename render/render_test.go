@@ -0,0 +1,75 @@
+package render
+
+import (
+	"bytes"
+	"image/gif"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+)
+
+func TestEncode(t *testing.T) {
+	g := grid.New(3, 2, false)
+	g.Set(grid.Point{X: 1, Y: 0}, true)
+
+	a := New(Options{Scale: 2, Delay: 3})
+	a.Add(GridFrame(g, func(_ grid.Point, wall bool) uint8 {
+		if wall {
+			return White
+		}
+		return Black
+	}))
+	a.Add(PointsFrame(3, 2, []grid.Point{{X: 2, Y: 1}, {X: 5, Y: 5}}, Green))
+
+	var buf bytes.Buffer
+	if err := a.Encode(&buf); err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := gif.DecodeAll(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(decoded.Image) != 2 {
+		t.Fatalf("got %d frames, want 2", len(decoded.Image))
+	}
+	if b := decoded.Image[0].Bounds(); b.Dx() != 6 || b.Dy() != 4 {
+		t.Errorf("frame is %dx%d, want 6x4", b.Dx(), b.Dy())
+	}
+	if decoded.Delay[0] != 3 {
+		t.Errorf("delay = %d, want 3", decoded.Delay[0])
+	}
+
+	// Every pixel of a scaled cell has its colour
+	for _, pt := range []struct{ x, y int }{{2, 0}, {3, 1}} {
+		if got := decoded.Image[0].ColorIndexAt(pt.x, pt.y); got != White {
+			t.Errorf("pixel (%d, %d) = %d, want the wall colour", pt.x, pt.y, got)
+		}
+	}
+	if got := decoded.Image[1].ColorIndexAt(5, 3); got != Green {
+		t.Errorf("robot pixel = %d, want green", got)
+	}
+}
+
+func TestEveryAndMax(t *testing.T) {
+	a := New(Options{Every: 3})
+	for range 7 {
+		a.Add(NewFrame(1, 1))
+	}
+	// Frames 0, 3 and 6, the last one is kept anyway
+	if a.Len() != 3 {
+		t.Errorf("Len() = %d, want 3", a.Len())
+	}
+
+	a = New(Options{Every: 2, Max: 2})
+	for range 10 {
+		a.Add(NewFrame(1, 1))
+	}
+	if a.Len() != 2 {
+		t.Errorf("Len() with Max = %d, want 2", a.Len())
+	}
+
+	if err := New(Options{}).Encode(&bytes.Buffer{}); err == nil {
+		t.Error("expected an error for an empty animation")
+	}
+}
@@ -0,0 +1,109 @@
+package search
+
+import "slices"
+
+// DAG holds every best path from the start. A state's predecessors are all the states a best path to it comes from.
+type DAG[S comparable] struct {
+	Start S
+	Dist  map[S]int
+	Prev  map[S][]S
+	Ends  []S // goal states reached at the lowest cost
+
+	counts map[S]int
+}
+
+// AllShortestPathsDAG is Dijkstra's search, keeping every best path instead of one. Edge costs have to be positive.
+// It stops once the goal states at the lowest cost are all reached, a nil goal explores everything reachable.
+func AllShortestPathsDAG[S comparable](start S, next Neighbours[S], goal func(S) bool) *DAG[S] {
+	d := &DAG[S]{Start: start, Dist: map[S]int{start: 0}, Prev: make(map[S][]S)}
+	done := make(map[S]bool)
+	queue := newQueue[S]()
+	queue.PushOrDecrease(start, 0)
+
+	for queue.Len() > 0 {
+		cur, cost := queue.Pop()
+		if len(d.Ends) > 0 && cost > d.Dist[d.Ends[0]] {
+			break
+		}
+		done[cur] = true
+
+		// Going on through a goal can only get to other goals at a higher cost
+		if goal != nil && goal(cur) {
+			d.Ends = append(d.Ends, cur)
+			continue
+		}
+
+		for _, e := range next(cur) {
+			if done[e.To] {
+				continue
+			}
+			cost := cost + e.Cost
+			switch old, seen := d.Dist[e.To]; {
+			case !seen || cost < old:
+				d.Dist[e.To] = cost
+				d.Prev[e.To] = []S{cur}
+				queue.PushOrDecrease(e.To, cost)
+			case cost == old:
+				d.Prev[e.To] = append(d.Prev[e.To], cur)
+			}
+		}
+	}
+	return d
+}
+
+// Paths returns every best path to a state, each from the start to it.
+func (d *DAG[S]) Paths(to S) [][]S {
+	if _, ok := d.Dist[to]; !ok {
+		return nil
+	}
+	if to == d.Start {
+		return [][]S{{to}}
+	}
+
+	var paths [][]S
+	for _, prev := range d.Prev[to] {
+		for _, path := range d.Paths(prev) {
+			paths = append(paths, append(path, to))
+		}
+	}
+	return paths
+}
+
+// Count returns the number of best paths to a state, without listing them.
+func (d *DAG[S]) Count(to S) int {
+	if _, ok := d.Dist[to]; !ok {
+		return 0
+	}
+	if to == d.Start {
+		return 1
+	}
+	if d.counts == nil {
+		d.counts = make(map[S]int)
+	}
+	if n, ok := d.counts[to]; ok {
+		return n
+	}
+
+	n := 0
+	for _, prev := range d.Prev[to] {
+		n += d.Count(prev)
+	}
+	d.counts[to] = n
+	return n
+}
+
+// On returns every state on a best path to any of the given states, including them.
+func (d *DAG[S]) On(to ...S) map[S]bool {
+	on := make(map[S]bool)
+	queue := slices.Clone(to)
+	for len(queue) > 0 {
+		cur := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if _, ok := d.Dist[cur]; !ok || on[cur] {
+			continue
+		}
+		on[cur] = true
+		queue = append(queue, d.Prev[cur]...)
+	}
+	return on
+}
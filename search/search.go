@@ -0,0 +1,147 @@
+// Package search finds shortest paths in graphs given by a neighbour function, so the days only describe their graph.
+// States can be anything comparable: a position, or a position and a direction.
+package search
+
+import (
+	"slices"
+
+	"github.com/SpicyHolo/advent_of_code_2024/pq"
+)
+
+// QueueKind is a priority queue the searches can use.
+type QueueKind int
+
+const (
+	IndexedQueue QueueKind = iota // pq.Indexed, finding a queued state in O(log n)
+	LinearQueue                   // pq.Linear, scanning the whole queue, as the days did before
+)
+
+// Queue is the priority queue of Dijkstra, AStar and AllShortestPathsDAG.
+// Only benchmarks should change it, to compare the queues on the days.
+var Queue = IndexedQueue
+
+func newQueue[S comparable]() pq.Queue[S, int] {
+	if Queue == LinearQueue {
+		return pq.NewLinear[S, int]()
+	}
+	return pq.NewIndexed[S, int]()
+}
+
+// Edge leads to a neighbouring state, at a cost.
+type Edge[S comparable] struct {
+	To   S
+	Cost int
+}
+
+// Neighbours lists the edges leaving a state.
+type Neighbours[S comparable] func(s S) []Edge[S]
+
+// Unit returns edges of cost 1 to every state.
+func Unit[S comparable](states ...S) []Edge[S] {
+	edges := make([]Edge[S], len(states))
+	for i, s := range states {
+		edges[i] = Edge[S]{To: s, Cost: 1}
+	}
+	return edges
+}
+
+// Result is the outcome of a search from a start state.
+type Result[S comparable] struct {
+	Start S
+	Dist  map[S]int // cost of the best path found to every state reached
+	Prev  map[S]S   // state before every state on its best path, but the start
+	End   S         // the goal state reached
+	Found bool      // whether a goal state was reached
+}
+
+func newResult[S comparable](start S) *Result[S] {
+	return &Result[S]{Start: start, Dist: map[S]int{start: 0}, Prev: make(map[S]S)}
+}
+
+// Path returns the best path to a state, from the start to it, or nil if it wasn't reached.
+func (r *Result[S]) Path(to S) []S {
+	if _, ok := r.Dist[to]; !ok {
+		return nil
+	}
+
+	path := []S{to}
+	for to != r.Start {
+		to = r.Prev[to]
+		path = append(path, to)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// BFS searches breadth first, every edge counts as a single step whatever its cost.
+// It stops at the first state goal accepts, a nil goal explores everything reachable.
+func BFS[S comparable](start S, next Neighbours[S], goal func(S) bool) *Result[S] {
+	r := newResult(start)
+	queue := []S{start}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+
+		if goal != nil && goal(cur) {
+			r.End, r.Found = cur, true
+			return r
+		}
+
+		for _, e := range next(cur) {
+			if _, seen := r.Dist[e.To]; seen {
+				continue
+			}
+			r.Dist[e.To] = r.Dist[cur] + 1
+			r.Prev[e.To] = cur
+			queue = append(queue, e.To)
+		}
+	}
+	return r
+}
+
+// Dijkstra finds the cheapest paths from the start, edge costs can't be negative.
+// It stops at the first state goal accepts, a nil goal explores everything reachable.
+func Dijkstra[S comparable](start S, next Neighbours[S], goal func(S) bool) *Result[S] {
+	return AStar(start, next, goal, nil)
+}
+
+// AStar is Dijkstra's search, going first where heuristic estimates the goal is closer.
+// The heuristic can't overestimate the cost to the goal, nor drop by more than an edge costs along it.
+// Once the goal is reached, Dist also holds the costs found so far to states still in the queue.
+func AStar[S comparable](start S, next Neighbours[S], goal func(S) bool, heuristic func(S) int) *Result[S] {
+	estimate := func(s S) int {
+		if heuristic == nil {
+			return 0
+		}
+		return heuristic(s)
+	}
+
+	r := newResult(start)
+	done := make(map[S]bool)
+	queue := newQueue[S]()
+	queue.PushOrDecrease(start, estimate(start))
+
+	for queue.Len() > 0 {
+		cur, _ := queue.Pop()
+		done[cur] = true
+
+		if goal != nil && goal(cur) {
+			r.End, r.Found = cur, true
+			return r
+		}
+
+		for _, e := range next(cur) {
+			if done[e.To] {
+				continue
+			}
+			cost := r.Dist[cur] + e.Cost
+			if old, seen := r.Dist[e.To]; seen && old <= cost {
+				continue
+			}
+			r.Dist[e.To] = cost
+			r.Prev[e.To] = cur
+			queue.PushOrDecrease(e.To, cost+estimate(e.To))
+		}
+	}
+	return r
+}
@@ -0,0 +1,161 @@
+package search
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/SpicyHolo/advent_of_code_2024/grid"
+)
+
+const maze = `#######
+#S....#
+#.#.#.#
+#.....#
+#.#.#E#
+#######
+`
+
+func parseMaze(t *testing.T) (*grid.Grid[byte], grid.Point, grid.Point) {
+	t.Helper()
+	g, err := grid.Bytes(strings.NewReader(maze))
+	if err != nil {
+		t.Fatal(err)
+	}
+	start, _ := g.Find('S')
+	end, _ := g.Find('E')
+	return g, start, end
+}
+
+func steps(g *grid.Grid[byte]) Neighbours[grid.Point] {
+	return func(p grid.Point) []Edge[grid.Point] {
+		var next []grid.Point
+		for n := range g.Neighbours4(p) {
+			if g.At(n) != '#' {
+				next = append(next, n)
+			}
+		}
+		return Unit(next...)
+	}
+}
+
+func TestShortestPath(t *testing.T) {
+	g, start, end := parseMaze(t)
+	manhattan := func(p grid.Point) int { return abs(p.X-end.X) + abs(p.Y-end.Y) }
+	isEnd := func(p grid.Point) bool { return p == end }
+
+	searches := map[string]func() *Result[grid.Point]{
+		"BFS":      func() *Result[grid.Point] { return BFS(start, steps(g), isEnd) },
+		"Dijkstra": func() *Result[grid.Point] { return Dijkstra(start, steps(g), isEnd) },
+		"AStar":    func() *Result[grid.Point] { return AStar(start, steps(g), isEnd, manhattan) },
+	}
+	for name, search := range searches {
+		r := search()
+		if !r.Found || r.End != end || r.Dist[end] != 7 {
+			t.Errorf("%s: found %v at %v, cost %d, want %v at 7", name, r.Found, r.End, r.Dist[end], end)
+		}
+
+		path := r.Path(end)
+		if len(path) != 8 || path[0] != start || path[7] != end {
+			t.Errorf("%s: path %v, want 8 positions from %v to %v", name, path, start, end)
+		}
+		for i := 1; i < len(path); i++ {
+			if d := path[i].Sub(path[i-1]); abs(d.X)+abs(d.Y) != 1 || g.At(path[i]) == '#' {
+				t.Errorf("%s: invalid step from %v to %v", name, path[i-1], path[i])
+			}
+		}
+	}
+
+	// Without a goal, every open cell is reached
+	if r := BFS(start, steps(g), nil); r.Found || len(r.Dist) != 16 {
+		t.Errorf("BFS reached %d cells, found %v, want 16 and false", len(r.Dist), r.Found)
+	}
+	if r := Dijkstra(start, steps(g), func(p grid.Point) bool { return p.X == 0 }); r.Found || r.Path(grid.Point{}) != nil {
+		t.Errorf("Dijkstra found an unreachable goal")
+	}
+}
+
+// Costs of moving between towns, where the cheapest way isn't the one with the fewest edges
+var roads = map[string][]Edge[string]{
+	"a": {{"b", 1}, {"c", 4}, {"d", 10}},
+	"b": {{"c", 2}, {"d", 7}},
+	"c": {{"d", 3}},
+	"d": {},
+}
+
+func TestWeighted(t *testing.T) {
+	next := func(s string) []Edge[string] { return roads[s] }
+
+	r := Dijkstra("a", next, nil)
+	if want := map[string]int{"a": 0, "b": 1, "c": 3, "d": 6}; !maps.Equal(r.Dist, want) {
+		t.Errorf("Dijkstra distances = %v, want %v", r.Dist, want)
+	}
+	if got := r.Path("d"); !slices.Equal(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("Dijkstra path = %v", got)
+	}
+
+	if r := BFS("a", next, nil); r.Dist["d"] != 1 {
+		t.Errorf("BFS counts %d steps to d, want 1", r.Dist["d"])
+	}
+}
+
+func TestAllShortestPaths(t *testing.T) {
+	g, start, end := parseMaze(t)
+	d := AllShortestPathsDAG(start, steps(g), func(p grid.Point) bool { return p == end })
+
+	if !slices.Equal(d.Ends, []grid.Point{end}) || d.Dist[end] != 7 {
+		t.Fatalf("ends %v at %d, want %v at 7", d.Ends, d.Dist[end], end)
+	}
+
+	// Going down to the middle row in any of the 3 columns
+	paths := d.Paths(end)
+	if len(paths) != 3 || d.Count(end) != 3 {
+		t.Errorf("%d paths, counted %d, want 3", len(paths), d.Count(end))
+	}
+	for _, path := range paths {
+		if len(path) != 8 || path[0] != start || path[7] != end {
+			t.Errorf("path %v, want 8 positions from %v to %v", path, start, end)
+		}
+	}
+
+	// The best paths cover the whole maze but the dead ends at the bottom
+	if on := d.On(end); len(on) != 14 || on[grid.Point{X: 1, Y: 4}] || on[grid.Point{X: 3, Y: 4}] {
+		t.Errorf("%d cells on a best path, want 14 without the dead ends", len(on))
+	}
+}
+
+func TestAllShortestPathsWithoutGoal(t *testing.T) {
+	diamond := map[string][]Edge[string]{
+		"a": {{"b", 1}, {"c", 1}},
+		"b": {{"d", 1}},
+		"c": {{"d", 1}},
+		"d": {{"e", 2}},
+	}
+	next := func(s string) []Edge[string] { return diamond[s] }
+
+	d := AllShortestPathsDAG("a", next, nil)
+	if d.Count("e") != 2 || d.Dist["e"] != 4 || len(d.Ends) != 0 {
+		t.Errorf("%d paths to e at %d, want 2 at 4", d.Count("e"), d.Dist["e"])
+	}
+	if d.Count("x") != 0 || d.Paths("x") != nil {
+		t.Error("found paths to an unknown state")
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// The searches find the same paths with the linear queue
+func TestLinearQueue(t *testing.T) {
+	defer func() { Queue = IndexedQueue }()
+	Queue = LinearQueue
+
+	t.Run("ShortestPath", TestShortestPath)
+	t.Run("Weighted", TestWeighted)
+	t.Run("AllShortestPaths", TestAllShortestPaths)
+}
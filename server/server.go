@@ -0,0 +1,120 @@
+// Package server exposes every registered solver over a JSON HTTP API.
+//
+//	GET  /days                  lists the days, and the parts each of them solves
+//	POST /days/{n}/parts/{p}    solves part p of day n, the request body is the puzzle input
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// MaxInputSize limits the size of a request body, the largest puzzle inputs are around 20KiB.
+const MaxInputSize = 1 << 20
+
+// DayInfo describes a registered day.
+type DayInfo struct {
+	Day   int   `json:"day"`
+	Parts []int `json:"parts"`
+}
+
+// Solution is the response to a solve request.
+type Solution struct {
+	Answer   string `json:"answer,omitempty"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+// New returns a handler serving the solvers. Every solve is cancelled after timeout, if it's positive.
+func New(timeout time.Duration) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /days", listDays)
+	mux.HandleFunc("POST /days/{n}/parts/{p}", func(w http.ResponseWriter, r *http.Request) {
+		solve(w, r, timeout)
+	})
+	return mux
+}
+
+func listDays(w http.ResponseWriter, r *http.Request) {
+	days := make([]DayInfo, 0)
+	for _, day := range solver.Days() {
+		s, _ := solver.Get(day)
+
+		info := DayInfo{Day: day, Parts: []int{}}
+		for part := 1; part <= 2; part++ {
+			if s.Part(part) != nil {
+				info.Parts = append(info.Parts, part)
+			}
+		}
+		days = append(days, info)
+	}
+	writeJSON(w, http.StatusOK, days)
+}
+
+func solve(w http.ResponseWriter, r *http.Request, timeout time.Duration) {
+	day, err1 := strconv.Atoi(r.PathValue("n"))
+	part, err2 := strconv.Atoi(r.PathValue("p"))
+	if err1 != nil || err2 != nil {
+		writeJSON(w, http.StatusBadRequest, Solution{Error: "day and part should be numbers"})
+		return
+	}
+
+	s, ok := solver.Get(day)
+	if !ok {
+		writeJSON(w, http.StatusNotFound, Solution{Error: fmt.Sprintf("no solver for day %d", day)})
+		return
+	}
+	if s.Part(part) == nil {
+		writeJSON(w, http.StatusNotFound, Solution{Error: fmt.Sprintf("day %d has no part %d", day, part)})
+		return
+	}
+
+	data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, MaxInputSize))
+	if err != nil {
+		status := http.StatusBadRequest
+		if tooLarge := new(http.MaxBytesError); errors.As(err, &tooLarge) {
+			status = http.StatusRequestEntityTooLarge
+		}
+		writeJSON(w, status, Solution{Error: fmt.Sprintf("could not read input: %v", err)})
+		return
+	}
+
+	ctx := r.Context()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	res := solver.Run(ctx, day, part, data)
+	status := http.StatusOK
+	sol := Solution{Duration: res.Duration.String()}
+	switch {
+	case errors.Is(res.Err, context.DeadlineExceeded):
+		status = http.StatusGatewayTimeout
+		sol.Error = fmt.Sprintf("solver did not finish within %v", timeout)
+	case res.Err != nil:
+		status = http.StatusUnprocessableEntity
+		sol.Error = res.Err.Error()
+	default:
+		sol.Answer = res.Answer
+	}
+	writeJSON(w, status, sol)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("could not write response", "err", err)
+	}
+}
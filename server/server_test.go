@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SpicyHolo/advent_of_code_2024/solver"
+)
+
+// Out of the puzzle range, so they never clash with a real day
+const (
+	echoDay = 100
+	slowDay = 101
+)
+
+func init() {
+	parse := func(r io.Reader) (string, error) {
+		data, err := io.ReadAll(r)
+		if err == nil && len(data) == 0 {
+			err = errors.New("empty input")
+		}
+		return string(data), err
+	}
+
+	upper := func(_ context.Context, s string) (string, error) { return strings.ToUpper(s), nil }
+	solver.Register(echoDay, solver.New(parse, upper, nil))
+
+	// Only finishes once it's cancelled
+	wait := func(ctx context.Context, _ string) (string, error) {
+		<-ctx.Done()
+		return "", ctx.Err()
+	}
+	solver.Register(slowDay, solver.New(parse, wait, wait))
+}
+
+func TestListDays(t *testing.T) {
+	srv := httptest.NewServer(New(time.Second))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/days")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	var days []DayInfo
+	if err := json.NewDecoder(resp.Body).Decode(&days); err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, d := range days {
+		if d.Day == echoDay {
+			found = true
+			if len(d.Parts) != 1 || d.Parts[0] != 1 {
+				t.Errorf("day %d lists parts %v, want [1]", echoDay, d.Parts)
+			}
+		}
+	}
+	if !found {
+		t.Errorf("day %d missing from %v", echoDay, days)
+	}
+}
+
+func TestSolve(t *testing.T) {
+	srv := httptest.NewServer(New(50 * time.Millisecond))
+	defer srv.Close()
+
+	tests := []struct {
+		name       string
+		path, body string
+		status     int
+		answer     string
+	}{
+		{"answer", "/days/100/parts/1", "abc", http.StatusOK, "ABC"},
+		{"parse error", "/days/100/parts/1", "", http.StatusUnprocessableEntity, ""},
+		{"missing part", "/days/100/parts/2", "abc", http.StatusNotFound, ""},
+		{"missing day", "/days/99/parts/1", "abc", http.StatusNotFound, ""},
+		{"invalid day", "/days/x/parts/1", "abc", http.StatusBadRequest, ""},
+		{"timeout", "/days/101/parts/1", "abc", http.StatusGatewayTimeout, ""},
+		{"too large", "/days/100/parts/1", strings.Repeat("a", MaxInputSize+1), http.StatusRequestEntityTooLarge, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := http.Post(srv.URL+tt.path, "text/plain", strings.NewReader(tt.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			var sol Solution
+			if err := json.NewDecoder(resp.Body).Decode(&sol); err != nil {
+				t.Fatal(err)
+			}
+
+			if resp.StatusCode != tt.status {
+				t.Errorf("status = %d, want %d (%+v)", resp.StatusCode, tt.status, sol)
+			}
+			if sol.Answer != tt.answer {
+				t.Errorf("answer = %q, want %q", sol.Answer, tt.answer)
+			}
+			if (tt.status == http.StatusOK) != (sol.Error == "") {
+				t.Errorf("unexpected error %q", sol.Error)
+			}
+		})
+	}
+}
+
+func TestMethodNotAllowed(t *testing.T) {
+	srv := httptest.NewServer(New(time.Second))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/days/100/parts/1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", resp.StatusCode, http.StatusMethodNotAllowed)
+	}
+}
@@ -0,0 +1,95 @@
+package solver
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Progress is how far a long running part got.
+type Progress struct {
+	Done, Total int
+	Elapsed     time.Duration
+}
+
+// ETA estimates the time left, assuming the rest goes as fast as what's done. It's 0 before anything is done.
+func (p Progress) ETA() time.Duration {
+	if p.Done == 0 || p.Done >= p.Total {
+		return 0
+	}
+	return time.Duration(float64(p.Elapsed) * float64(p.Total-p.Done) / float64(p.Done))
+}
+
+func (p Progress) String() string {
+	if p.Total == 0 {
+		return fmt.Sprintf("%d done", p.Done)
+	}
+	percent := 100 * p.Done / p.Total
+	return fmt.Sprintf("%d/%d (%d%%), %v left", p.Done, p.Total, percent, p.ETA().Round(time.Second))
+}
+
+// How often a Tracker reports, at most
+const reportInterval = 100 * time.Millisecond
+
+type progressKey struct{}
+
+// WithProgress returns a context, passing the progress of the parts solved with it to report.
+// Report is called from the goroutine running the part.
+func WithProgress(ctx context.Context, report func(Progress)) context.Context {
+	return context.WithValue(ctx, progressKey{}, report)
+}
+
+// Tracker counts the work done by a part, and reports it to the callback of the part's context.
+// It's not safe for concurrent use.
+type Tracker struct {
+	report   func(Progress)
+	progress Progress
+	start    time.Time
+	reported time.Time
+}
+
+// Track starts tracking a part with total steps to do.
+func Track(ctx context.Context, total int) *Tracker {
+	report, _ := ctx.Value(progressKey{}).(func(Progress))
+	return &Tracker{report: report, progress: Progress{Total: total}, start: time.Now()}
+}
+
+// Add counts n more steps as done. The progress is reported every so often, and once everything is done.
+func (t *Tracker) Add(n int) {
+	t.progress.Done += n
+	if t.report == nil {
+		return
+	}
+
+	now := time.Now()
+	if now.Sub(t.reported) < reportInterval && t.progress.Done < t.progress.Total {
+		return
+	}
+	t.reported = now
+	t.report(t.Progress())
+}
+
+// Progress returns the steps done so far.
+func (t *Tracker) Progress() Progress {
+	p := t.progress
+	p.Elapsed = time.Since(t.start)
+	return p
+}
+
+// Partial wraps the error that stopped the part, with the answer over the steps done so far.
+func (t *Tracker) Partial(answer string, err error) error {
+	return &PartialError{Answer: answer, Progress: t.Progress(), Err: err}
+}
+
+// PartialError is returned by a part stopped before it finished, such as on cancellation or a deadline.
+type PartialError struct {
+	Answer   string // answer over the work done so far
+	Progress Progress
+	Err      error
+}
+
+func (e *PartialError) Error() string {
+	return fmt.Sprintf("stopped at %d/%d, partial answer %s: %v", e.Progress.Done, e.Progress.Total, e.Answer, e.Err)
+}
+
+func (e *PartialError) Unwrap() error { return e.Err }
@@ -0,0 +1,48 @@
+package solver
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTracker(t *testing.T) {
+	var reports []Progress
+	ctx := WithProgress(context.Background(), func(p Progress) { reports = append(reports, p) })
+
+	progress := Track(ctx, 1000)
+	for range 1000 {
+		progress.Add(1)
+	}
+
+	// The first step, and the last one, rate limited in between
+	if len(reports) < 2 || len(reports) > 10 {
+		t.Fatalf("got %d reports, want a few", len(reports))
+	}
+	if last := reports[len(reports)-1]; last.Done != 1000 || last.Total != 1000 {
+		t.Errorf("last report = %v, want everything done", last)
+	}
+
+	// Without a callback nothing is reported, but the steps are still counted
+	untracked := Track(context.Background(), 10)
+	untracked.Add(3)
+	err := untracked.Partial("7", context.DeadlineExceeded)
+	var partial *PartialError
+	if !errors.As(err, &partial) || partial.Answer != "7" || partial.Progress.Done != 3 {
+		t.Errorf("Partial() = %v", err)
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Partial() = %v, should wrap the reason it stopped", err)
+	}
+}
+
+func TestETA(t *testing.T) {
+	p := Progress{Done: 25, Total: 100, Elapsed: time.Second}
+	if got := p.ETA(); got != 3*time.Second {
+		t.Errorf("ETA() = %v, want 3s", got)
+	}
+	if got := (Progress{Total: 100, Elapsed: time.Second}).ETA(); got != 0 {
+		t.Errorf("ETA() before any progress = %v, want 0", got)
+	}
+}
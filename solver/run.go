@@ -0,0 +1,52 @@
+package solver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+)
+
+// Result is the outcome of running one part of a day.
+type Result struct {
+	Day, Part int
+	Answer    string
+	Duration  time.Duration
+	Err       error
+}
+
+func (r Result) String() string {
+	if r.Err != nil {
+		return fmt.Sprintf("day %02d part %d: error: %v", r.Day, r.Part, r.Err)
+	}
+	return fmt.Sprintf("day %02d part %d: %s (%v)", r.Day, r.Part, r.Answer, r.Duration)
+}
+
+// Run parses the input and solves a single part of a day.
+// The duration covers both parsing and solving.
+func Run(ctx context.Context, day, part int, data []byte) Result {
+	res := Result{Day: day, Part: part}
+
+	s, ok := Get(day)
+	if !ok {
+		res.Err = fmt.Errorf("no solver registered for day %d", day)
+		return res
+	}
+
+	solve := s.Part(part)
+	if solve == nil {
+		res.Err = fmt.Errorf("part %d is not implemented", part)
+		return res
+	}
+
+	start := time.Now()
+	input, err := s.Parse(bytes.NewReader(data))
+	if err != nil {
+		res.Err = fmt.Errorf("could not parse input: %w", err)
+		return res
+	}
+
+	res.Answer, res.Err = solve(ctx, input)
+	res.Duration = time.Since(start)
+	return res
+}
@@ -0,0 +1,79 @@
+// Package solver defines the common shape of a day's solution, so every
+// puzzle can be parsed, solved and reported the same way.
+package solver
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// Solver holds the three phases of a day's solution.
+// Parse is called once for every part, so parts are free to modify their input.
+// Long running parts should give up, and return ctx.Err(), once ctx is done.
+type Solver struct {
+	Parse func(r io.Reader) (any, error)
+	Part1 func(ctx context.Context, input any) (string, error)
+	Part2 func(ctx context.Context, input any) (string, error)
+}
+
+// New wraps typed parse and part functions into a Solver.
+func New[T any](parse func(r io.Reader) (T, error), part1, part2 func(ctx context.Context, input T) (string, error)) Solver {
+	wrap := func(part func(context.Context, T) (string, error)) func(context.Context, any) (string, error) {
+		if part == nil {
+			return nil
+		}
+		return func(ctx context.Context, input any) (string, error) {
+			typed, ok := input.(T)
+			if !ok {
+				return "", fmt.Errorf("unexpected input type %T", input)
+			}
+			return part(ctx, typed)
+		}
+	}
+
+	return Solver{
+		Parse: func(r io.Reader) (any, error) { return parse(r) },
+		Part1: wrap(part1),
+		Part2: wrap(part2),
+	}
+}
+
+// Part returns the function solving the given part (1 or 2), or nil if there is none.
+func (s Solver) Part(part int) func(ctx context.Context, input any) (string, error) {
+	switch part {
+	case 1:
+		return s.Part1
+	case 2:
+		return s.Part2
+	}
+	return nil
+}
+
+var registry = make(map[int]Solver)
+
+// Register makes a solver available under its day number.
+// Registering the same day twice panics.
+func Register(day int, s Solver) {
+	if _, exists := registry[day]; exists {
+		panic(fmt.Sprintf("solver: day %d registered twice", day))
+	}
+	registry[day] = s
+}
+
+// Get returns the solver registered for a day.
+func Get(day int) (Solver, bool) {
+	s, ok := registry[day]
+	return s, ok
+}
+
+// Days returns all registered days in ascending order.
+func Days() []int {
+	days := make([]int, 0, len(registry))
+	for day := range registry {
+		days = append(days, day)
+	}
+	slices.Sort(days)
+	return days
+}